@@ -0,0 +1,178 @@
+package kong
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/kevholditch/gokong"
+)
+
+// adminApiClient is used for the parts of the kong admin api that gokong does not cover. It authenticates in exactly
+// the same way as the gokong client so both can be used side by side.
+type adminApiClient struct {
+	config     *gokong.Config
+	httpClient *http.Client
+}
+
+func newAdminApiClient(kongConfig *gokong.Config) *adminApiClient {
+	return &adminApiClient{
+		config: kongConfig,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: kongConfig.InsecureSkipVerify},
+			},
+		},
+	}
+}
+
+// get reads path into result, it returns false if kong answered with a 404.
+func (client *adminApiClient) get(path string, result interface{}) (bool, error) {
+	status, err := client.do(http.MethodGet, path, nil, result)
+	if status == http.StatusNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// list follows kong's pagination from path and returns the raw entries of every page.
+func (client *adminApiClient) list(path string) ([]json.RawMessage, error) {
+	var results []json.RawMessage
+
+	for path != "" {
+		page := &struct {
+			Data []json.RawMessage `json:"data"`
+			Next *string           `json:"next"`
+		}{}
+
+		if _, err := client.do(http.MethodGet, path, nil, page); err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Data...)
+
+		path = ""
+		if page.Next != nil {
+			path = *page.Next
+			// Some versions of kong return an absolute url rather than a path
+			if next, err := url.Parse(path); err == nil && next.IsAbs() {
+				path = next.RequestURI()
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func (client *adminApiClient) post(path string, request interface{}, result interface{}) error {
+	_, err := client.do(http.MethodPost, path, request, result)
+	return err
+}
+
+func (client *adminApiClient) put(path string, request interface{}, result interface{}) error {
+	_, err := client.do(http.MethodPut, path, request, result)
+	return err
+}
+
+func (client *adminApiClient) patch(path string, request interface{}, result interface{}) error {
+	_, err := client.do(http.MethodPatch, path, request, result)
+	return err
+}
+
+// delete removes path, a 404 is not treated as an error as the entity is already gone.
+func (client *adminApiClient) delete(path string) error {
+	status, err := client.do(http.MethodDelete, path, nil, nil)
+	if status == http.StatusNotFound {
+		return nil
+	}
+
+	return err
+}
+
+func (client *adminApiClient) do(method string, path string, request interface{}, result interface{}) (int, error) {
+	var body *bytes.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return 0, fmt.Errorf("could not marshal request for %s %s, error: %v", method, path, err)
+		}
+		body = bytes.NewReader(data)
+	} else {
+		body = bytes.NewReader(nil)
+	}
+
+	httpRequest, err := http.NewRequest(method, strings.TrimRight(client.config.HostAddress, "/")+path, body)
+	if err != nil {
+		return 0, fmt.Errorf("could not build request for %s %s, error: %v", method, path, err)
+	}
+
+	if request != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	if client.config.Username != "" || client.config.Password != "" {
+		httpRequest.SetBasicAuth(client.config.Username, client.config.Password)
+	}
+	if client.config.ApiKey != "" {
+		httpRequest.Header.Set("apikey", client.config.ApiKey)
+	}
+	if client.config.AdminToken != "" {
+		httpRequest.Header.Set("kong-admin-token", client.config.AdminToken)
+	}
+
+	response, err := client.httpClient.Do(httpRequest)
+	if err != nil {
+		return 0, fmt.Errorf("could not call %s %s, error: %v", method, path, err)
+	}
+	defer response.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, fmt.Errorf("could not read response of %s %s, error: %v", method, path, err)
+	}
+
+	if response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden {
+		return response.StatusCode, fmt.Errorf("not authorised, message from kong: %s", responseBody)
+	}
+
+	if response.StatusCode >= 400 {
+		return response.StatusCode, fmt.Errorf("%s %s failed with status %d, message from kong: %s", method, path, response.StatusCode, responseBody)
+	}
+
+	if result != nil && len(responseBody) > 0 {
+		if err := json.Unmarshal(responseBody, result); err != nil {
+			return response.StatusCode, fmt.Errorf("could not parse response of %s %s, error: %v", method, path, err)
+		}
+	}
+
+	return response.StatusCode, nil
+}
+
+// kongVersionAtLeast tells whether the kong behind the admin api is at least the minimum version, the enterprise
+// suffix is dropped first as it would otherwise count as a pre-release of the version
+func kongVersionAtLeast(meta interface{}, minimum string) (bool, error) {
+	nodeInfo := &struct {
+		Version string `json:"version"`
+	}{}
+
+	if _, err := meta.(*config).adminApi.get("/", nodeInfo); err != nil {
+		return false, fmt.Errorf("could not read kong node information: %v", err)
+	}
+
+	current, err := version.NewVersion(strings.SplitN(nodeInfo.Version, "-", 2)[0])
+	if err != nil {
+		return false, fmt.Errorf("could not parse kong version %s: %v", nodeInfo.Version, err)
+	}
+
+	return current.GreaterThanOrEqual(version.Must(version.NewVersion(minimum))), nil
+}
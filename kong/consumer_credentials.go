@@ -0,0 +1,80 @@
+package kong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Credentials live under the same /consumers/{consumer_id}/{plugin_name} endpoints that kong_consumer_plugin_config
+// uses, the typed credential resources give each plugin a proper schema on top of them.
+
+func consumerCredentialPath(consumerId string, pluginName string, id string) string {
+	path := "/consumers/" + consumerId + "/" + pluginName
+	if id != "" {
+		path += "/" + id
+	}
+	return path
+}
+
+func buildConsumerCredentialId(consumerId string, credentialId string) string {
+	return consumerId + "/" + credentialId
+}
+
+func splitConsumerCredentialId(id string) (string, string, error) {
+	idSplit := strings.Split(id, "/")
+
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("failed to calculate consumer credential id, should be slash separated as consumerId/credentialId found: %v", id)
+	}
+
+	return idSplit[0], idSplit[1], nil
+}
+
+// readConsumerCredential reads a credential into result, it clears the id when either the consumer or the
+// credential no longer exist and returns false.
+func readConsumerCredential(d *schema.ResourceData, meta interface{}, pluginName string, result interface{}) (bool, error) {
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return false, err
+	}
+
+	// First check if the consumer exists. If it does not then the credential no longer exists either.
+	if consumer, _ := meta.(*config).adminClient.Consumers().GetById(consumerId); consumer == nil {
+		d.SetId("")
+		return false, nil
+	}
+
+	found, err := meta.(*config).adminApi.get(consumerCredentialPath(consumerId, pluginName, credentialId), result)
+
+	if err != nil {
+		return false, fmt.Errorf("could not find kong consumer %s credential with id: %s error: %v", pluginName, d.Id(), err)
+	}
+
+	if !found {
+		d.SetId("")
+		return false, nil
+	}
+
+	d.Set("consumer_id", consumerId)
+
+	return true, nil
+}
+
+func deleteConsumerCredential(d *schema.ResourceData, meta interface{}, pluginName string) error {
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*config).adminApi.delete(consumerCredentialPath(consumerId, pluginName, credentialId)); err != nil {
+		return fmt.Errorf("could not delete kong consumer %s credential: %v", pluginName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package kong
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKongCertificate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"sni": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SNI name the certificate is attached to e.g. www.example.com",
+			},
+			"certificate_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sans": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"not_before": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKongCertificateRead(d *schema.ResourceData, meta interface{}) error {
+
+	client := meta.(*config).adminClient
+	name := readStringFromResource(d, "sni")
+
+	sni, err := client.Snis().GetByName(name)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong sni: %v", err)
+	}
+
+	if sni == nil || sni.CertificateId == nil {
+		return fmt.Errorf("could not find a kong sni with name: %s", name)
+	}
+
+	certificate, err := client.Certificates().GetById(string(*sni.CertificateId))
+
+	if err != nil {
+		return fmt.Errorf("could not find kong certificate: %v", err)
+	}
+
+	if certificate == nil || certificate.Cert == nil {
+		return fmt.Errorf("could not find kong certificate attached to sni: %s", name)
+	}
+
+	parsed, err := parsePemCertificate(*certificate.Cert)
+	if err != nil {
+		return fmt.Errorf("could not parse kong certificate %s: %v", *certificate.Id, err)
+	}
+
+	// The private key is deliberately never read into state, this data source is for sharing the public part only.
+	d.SetId(*certificate.Id)
+	d.Set("certificate_id", certificate.Id)
+	d.Set("certificate", certificate.Cert)
+	d.Set("subject", parsed.Subject.String())
+	d.Set("sans", certificateSans(parsed))
+	d.Set("not_before", parsed.NotBefore.UTC().Format(time.RFC3339))
+	d.Set("not_after", parsed.NotAfter.UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func parsePemCertificate(data string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func certificateSans(certificate *x509.Certificate) []string {
+	sans := make([]string, 0)
+	sans = append(sans, certificate.DNSNames...)
+	for _, ip := range certificate.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+	sans = append(sans, certificate.EmailAddresses...)
+	for _, uri := range certificate.URIs {
+		sans = append(sans, uri.String())
+	}
+
+	return sans
+}
@@ -0,0 +1,49 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongCertificateDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCertificateDataSourceConfig, testCert1, testKey1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kong_certificate.certificate", "certificate_id", "kong_certificate.certificate", "id"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate", "certificate", testCert1+"\n"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate", "subject", "CN=gokong,O=kevholditch,L=Cambridge,ST=CAMB,C=GB"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate", "sans.#", "0"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate", "not_before", "2019-01-14T21:18:11Z"),
+					resource.TestCheckResourceAttr("data.kong_certificate.certificate", "not_after", "2029-01-11T21:18:11Z"),
+					resource.TestCheckNoResourceAttr("data.kong_certificate.certificate", "private_key"),
+				),
+			},
+		},
+	})
+}
+
+const testCertificateDataSourceConfig = `
+resource "kong_certificate" "certificate" {
+	certificate  = <<EOF
+%s
+EOF
+	private_key =  <<EOF
+%s
+EOF
+}
+
+resource "kong_sni" "sni" {
+	name  		   = "www.example.com"
+	certificate_id = "${kong_certificate.certificate.id}"
+}
+
+data "kong_certificate" "certificate" {
+	sni = "${kong_sni.sni.name}"
+}
+`
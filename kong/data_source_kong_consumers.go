@@ -0,0 +1,77 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongConsumers() *schema.Resource {
+	dataSourceSchema := tagsFilterSchema()
+	dataSourceSchema["consumers"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"username": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"custom_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKongConsumersRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceKongConsumersRead(d *schema.ResourceData, meta interface{}) error {
+
+	entities, id, err := listEntitiesByTags(d, meta, "/consumers")
+
+	if err != nil {
+		return fmt.Errorf("could not list kong consumers: %v", err)
+	}
+
+	consumers := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		consumer := &gokong.Consumer{}
+		if err := json.Unmarshal(entity, consumer); err != nil {
+			return fmt.Errorf("could not parse kong consumer: %v", err)
+		}
+
+		m := make(map[string]interface{})
+		m["id"] = consumer.Id
+		m["username"] = consumer.Username
+		m["custom_id"] = consumer.CustomId
+		m["tags"] = readEntityTags(entity)
+
+		consumers = append(consumers, m)
+	}
+
+	d.SetId(id)
+	if err := d.Set("consumers", consumers); err != nil {
+		return err
+	}
+
+	return nil
+}
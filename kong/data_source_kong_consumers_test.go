@@ -0,0 +1,34 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongConsumersDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsumersDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEntityListed("data.kong_consumers.consumers", "consumers", "username", "ListedUser"),
+					testAccCheckKongEntityListed("data.kong_consumers.consumers", "consumers", "custom_id", "listed-123"),
+				),
+			},
+		},
+	})
+}
+
+const testConsumersDataSourceConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "ListedUser"
+	custom_id = "listed-123"
+}
+
+data "kong_consumers" "consumers" {
+	depends_on = ["kong_consumer.consumer"]
+}
+`
@@ -0,0 +1,171 @@
+package kong
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type kongNodeInfo struct {
+	Version       string                 `json:"version"`
+	Edition       string                 `json:"edition"`
+	Hostname      string                 `json:"hostname"`
+	NodeId        string                 `json:"node_id"`
+	LuaVersion    string                 `json:"lua_version"`
+	Tagline       string                 `json:"tagline"`
+	Plugins       kongNodePlugins        `json:"plugins"`
+	Configuration map[string]interface{} `json:"configuration"`
+}
+
+type kongNodePlugins struct {
+	// Kong 1.x maps plugin names to true, later versions map them to an object with the plugin version
+	AvailableOnServer map[string]interface{} `json:"available_on_server"`
+	EnabledInCluster  []string               `json:"enabled_in_cluster"`
+}
+
+func dataSourceKongNodeInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongNodeInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"hostname": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"node_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"lua_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tagline": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"edition": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Either community or enterprise",
+			},
+			"database": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The database kong is configured with e.g. postgres, cassandra or off when running db-less",
+			},
+			"plugins": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available_on_server": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"enabled_in_cluster": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"configuration": &schema.Schema{
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The scalar values of the node configuration, kong redacts sensitive values itself",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongNodeInfoRead(d *schema.ResourceData, meta interface{}) error {
+
+	nodeInfo := &kongNodeInfo{}
+
+	if _, err := meta.(*config).adminApi.get("/", nodeInfo); err != nil {
+		return fmt.Errorf("could not read kong node information: %v", err)
+	}
+
+	d.SetId(nodeInfo.NodeId)
+	d.Set("version", nodeInfo.Version)
+	d.Set("hostname", nodeInfo.Hostname)
+	d.Set("node_id", nodeInfo.NodeId)
+	d.Set("lua_version", nodeInfo.LuaVersion)
+	d.Set("tagline", nodeInfo.Tagline)
+	d.Set("edition", kongEdition(nodeInfo))
+
+	configuration := flattenNodeConfiguration(nodeInfo.Configuration)
+	d.Set("database", configuration["database"])
+	if err := d.Set("configuration", configuration); err != nil {
+		return err
+	}
+
+	if err := d.Set("plugins", flattenNodePlugins(&nodeInfo.Plugins)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Newer versions report the edition directly, older enterprise versions are either suffixed with
+// -enterprise-edition or carry a fourth version component e.g. 2.8.1.0
+func kongEdition(nodeInfo *kongNodeInfo) string {
+	if nodeInfo.Edition != "" {
+		return nodeInfo.Edition
+	}
+	if strings.Contains(nodeInfo.Version, "enterprise") || len(strings.Split(nodeInfo.Version, ".")) > 3 {
+		return "enterprise"
+	}
+	return "community"
+}
+
+func flattenNodePlugins(in *kongNodePlugins) []interface{} {
+	available := make([]string, 0, len(in.AvailableOnServer))
+	for name := range in.AvailableOnServer {
+		available = append(available, name)
+	}
+	sort.Strings(available)
+
+	enabled := make([]string, len(in.EnabledInCluster))
+	copy(enabled, in.EnabledInCluster)
+	sort.Strings(enabled)
+
+	m := make(map[string]interface{})
+	m["available_on_server"] = available
+	m["enabled_in_cluster"] = enabled
+
+	return []interface{}{m}
+}
+
+// Terraform maps can only hold a single type so nested values (lists and objects) are skipped
+func flattenNodeConfiguration(in map[string]interface{}) map[string]string {
+	configuration := make(map[string]string)
+	for key, value := range in {
+		switch v := value.(type) {
+		case string:
+			configuration[key] = v
+		case bool:
+			configuration[key] = strconv.FormatBool(v)
+		case float64:
+			configuration[key] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+	return configuration
+}
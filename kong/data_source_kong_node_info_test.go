@@ -0,0 +1,37 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongNodeInfoDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testNodeInfoDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_node_info.node", "version", GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion)),
+					resource.TestCheckResourceAttr("data.kong_node_info.node", "edition", "community"),
+					resource.TestCheckResourceAttr("data.kong_node_info.node", "database", "postgres"),
+					resource.TestCheckResourceAttr("data.kong_node_info.node", "configuration.database", "postgres"),
+					resource.TestCheckResourceAttrSet("data.kong_node_info.node", "hostname"),
+					resource.TestCheckResourceAttrSet("data.kong_node_info.node", "node_id"),
+					resource.TestCheckResourceAttrSet("data.kong_node_info.node", "plugins.0.available_on_server.#"),
+					resource.TestCheckOutput("rate_limiting_available", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testNodeInfoDataSourceConfig = `
+data "kong_node_info" "node" {}
+
+output "rate_limiting_available" {
+	value = "${contains(data.kong_node_info.node.plugins.0.available_on_server, "rate-limiting")}"
+}
+`
@@ -0,0 +1,157 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Kong 1.x serves plugin schemas from /plugins/schema/{name}, this was later replaced by /schemas/plugins/{name}.
+// Both return the same format so we try them in order.
+var pluginSchemaPaths = []string{"/plugins/schema/%s", "/schemas/plugins/%s"}
+
+type pluginSchemaField struct {
+	Type     string                         `json:"type"`
+	Required bool                           `json:"required"`
+	Default  interface{}                    `json:"default"`
+	OneOf    []interface{}                  `json:"one_of"`
+	Fields   []map[string]pluginSchemaField `json:"fields"`
+}
+
+type pluginSchema struct {
+	Fields []map[string]pluginSchemaField `json:"fields"`
+}
+
+func dataSourceKongPluginSchema() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongPluginSchemaRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the plugin e.g. rate-limiting",
+			},
+			"json": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The raw JSON schema of the plugin as returned by kong",
+			},
+			"config_fields": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fields of the plugin config, nested fields are named using dots e.g. limits.second",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"one_of": &schema.Schema{
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongPluginSchemaRead(d *schema.ResourceData, meta interface{}) error {
+
+	name := readStringFromResource(d, "name")
+
+	var raw json.RawMessage
+	found := false
+	for _, path := range pluginSchemaPaths {
+		var err error
+		if found, err = meta.(*config).adminApi.get(fmt.Sprintf(path, name), &raw); err != nil {
+			return fmt.Errorf("could not read kong plugin schema for %s: %v", name, err)
+		}
+		if found {
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("could not find kong plugin schema for %s", name)
+	}
+
+	parsed := &pluginSchema{}
+	if err := json.Unmarshal(raw, parsed); err != nil {
+		return fmt.Errorf("could not parse kong plugin schema for %s: %v", name, err)
+	}
+
+	configFields := make([]interface{}, 0)
+	for _, field := range parsed.Fields {
+		if config, ok := field["config"]; ok {
+			configFields = flattenPluginSchemaFields("", config.Fields, configFields)
+		}
+	}
+
+	d.SetId(name)
+	d.Set("json", string(raw))
+	if err := d.Set("config_fields", configFields); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenPluginSchemaFields(prefix string, fields []map[string]pluginSchemaField, out []interface{}) []interface{} {
+	for _, field := range fields {
+		for name, value := range field {
+			m := make(map[string]interface{})
+			m["name"] = prefix + name
+			m["type"] = value.Type
+			m["required"] = value.Required
+			m["default"] = pluginSchemaValueToString(value.Default)
+
+			oneOf := make([]string, len(value.OneOf))
+			for i, item := range value.OneOf {
+				oneOf[i] = pluginSchemaValueToString(item)
+			}
+			m["one_of"] = oneOf
+
+			out = append(out, m)
+
+			if value.Type == "record" {
+				out = flattenPluginSchemaFields(prefix+name+".", value.Fields, out)
+			}
+		}
+	}
+	return out
+}
+
+// Strings and numbers are returned as is, anything else is returned as JSON so it can be decoded with jsondecode
+func pluginSchemaValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		rawJson, _ := json.Marshal(v)
+		return string(rawJson)
+	}
+}
@@ -0,0 +1,79 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongPluginSchemaDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginSchemaDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_plugin_schema.rate_limiting", "name", "rate-limiting"),
+					resource.TestMatchResourceAttr("data.kong_plugin_schema.rate_limiting", "json", regexp.MustCompile(`"fields"`)),
+					testAccCheckKongPluginSchemaField("data.kong_plugin_schema.rate_limiting", "second", "number", ""),
+					testAccCheckKongPluginSchemaField("data.kong_plugin_schema.rate_limiting", "policy", "string", "cluster"),
+				),
+			},
+			{
+				Config:      testUnknownPluginSchemaDataSourceConfig,
+				ExpectError: regexp.MustCompile("could not find kong plugin schema for not-a-plugin"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongPluginSchemaField(resourceKey string, name string, fieldType string, defaultValue string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["config_fields.#"])
+		if err != nil {
+			return fmt.Errorf("could not read config_fields count: %v", err)
+		}
+
+		for i := 0; i < count; i++ {
+			prefix := fmt.Sprintf("config_fields.%d.", i)
+			if rs.Primary.Attributes[prefix+"name"] != name {
+				continue
+			}
+
+			if rs.Primary.Attributes[prefix+"type"] != fieldType {
+				return fmt.Errorf("expected field %s to have type %s found %s", name, fieldType, rs.Primary.Attributes[prefix+"type"])
+			}
+
+			if rs.Primary.Attributes[prefix+"default"] != defaultValue {
+				return fmt.Errorf("expected field %s to have default %s found %s", name, defaultValue, rs.Primary.Attributes[prefix+"default"])
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("config field %s not found in plugin schema", name)
+	}
+}
+
+const testPluginSchemaDataSourceConfig = `
+data "kong_plugin_schema" "rate_limiting" {
+	name = "rate-limiting"
+}
+`
+const testUnknownPluginSchemaDataSourceConfig = `
+data "kong_plugin_schema" "unknown" {
+	name = "not-a-plugin"
+}
+`
@@ -0,0 +1,97 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongPlugins() *schema.Resource {
+	dataSourceSchema := tagsFilterSchema()
+	dataSourceSchema["plugins"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"consumer_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"service_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"route_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"enabled": &schema.Schema{
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"config_json": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKongPluginsRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceKongPluginsRead(d *schema.ResourceData, meta interface{}) error {
+
+	entities, id, err := listEntitiesByTags(d, meta, "/plugins")
+
+	if err != nil {
+		return fmt.Errorf("could not list kong plugins: %v", err)
+	}
+
+	plugins := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		plugin := &gokong.Plugin{}
+		if err := json.Unmarshal(entity, plugin); err != nil {
+			return fmt.Errorf("could not parse kong plugin: %v", err)
+		}
+
+		m := make(map[string]interface{})
+		m["id"] = plugin.Id
+		m["name"] = plugin.Name
+		m["consumer_id"] = gokong.IdToString(plugin.ConsumerId)
+		m["service_id"] = gokong.IdToString(plugin.ServiceId)
+		m["route_id"] = gokong.IdToString(plugin.RouteId)
+		m["enabled"] = plugin.Enabled
+		m["config_json"] = pluginConfigJsonToString(plugin.Config)
+		m["tags"] = readEntityTags(entity)
+
+		plugins = append(plugins, m)
+	}
+
+	d.SetId(id)
+	if err := d.Set("plugins", plugins); err != nil {
+		return err
+	}
+
+	return nil
+}
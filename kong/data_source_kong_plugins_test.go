@@ -0,0 +1,38 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongPluginsDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEntityListed("data.kong_plugins.plugins", "plugins", "name", "rate-limiting"),
+				),
+			},
+		},
+	})
+}
+
+const testPluginsDataSourceConfig = `
+resource "kong_plugin" "rate_limit" {
+	name        = "rate-limiting"
+	config_json = <<EOT
+	{
+		"second": 5,
+		"hour" : 1000
+	}
+EOT
+}
+
+data "kong_plugins" "plugins" {
+	depends_on = ["kong_plugin.rate_limit"]
+}
+`
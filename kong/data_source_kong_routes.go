@@ -0,0 +1,172 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongRoutes() *schema.Resource {
+	dataSourceSchema := tagsFilterSchema()
+	dataSourceSchema["routes"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocols": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"methods": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"hosts": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"paths": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"strip_path": &schema.Schema{
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"source":      ipPortComputedSchema(),
+				"destination": ipPortComputedSchema(),
+				"snis": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"preserve_host": &schema.Schema{
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"regex_priority": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"service_id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKongRoutesRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func ipPortComputedSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ip": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongRoutesRead(d *schema.ResourceData, meta interface{}) error {
+
+	entities, id, err := listEntitiesByTags(d, meta, "/routes")
+
+	if err != nil {
+		return fmt.Errorf("could not list kong routes: %v", err)
+	}
+
+	routes := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		route := &gokong.Route{}
+		if err := json.Unmarshal(entity, route); err != nil {
+			return fmt.Errorf("could not parse kong route: %v", err)
+		}
+		routes = append(routes, flattenRouteEntity(route, readEntityTags(entity)))
+	}
+
+	d.SetId(id)
+	if err := d.Set("routes", routes); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenRouteEntity(in *gokong.Route, tags []string) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if in.Id != nil {
+		m["id"] = *in.Id
+	}
+	if in.Name != nil {
+		m["name"] = *in.Name
+	}
+	m["protocols"] = gokong.StringValueSlice(in.Protocols)
+	m["methods"] = gokong.StringValueSlice(in.Methods)
+	m["hosts"] = gokong.StringValueSlice(in.Hosts)
+	m["paths"] = gokong.StringValueSlice(in.Paths)
+	if in.StripPath != nil {
+		m["strip_path"] = *in.StripPath
+	}
+	m["source"] = flattenIpPorts(in.Sources)
+	m["destination"] = flattenIpPorts(in.Destinations)
+	m["snis"] = gokong.StringValueSlice(in.Snis)
+	if in.PreserveHost != nil {
+		m["preserve_host"] = *in.PreserveHost
+	}
+	if in.RegexPriority != nil {
+		m["regex_priority"] = *in.RegexPriority
+	}
+	m["service_id"] = gokong.IdToString(in.Service)
+	m["tags"] = tags
+
+	return m
+}
+
+func flattenIpPorts(in []*gokong.IpPort) []interface{} {
+	out := make([]interface{}, 0, len(in))
+	for _, ipPort := range in {
+		m := make(map[string]interface{})
+		if ipPort.Ip != nil {
+			m["ip"] = *ipPort.Ip
+		}
+		if ipPort.Port != nil {
+			m["port"] = *ipPort.Port
+		}
+		out = append(out, m)
+	}
+	return out
+}
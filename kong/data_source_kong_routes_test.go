@@ -0,0 +1,41 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongRoutesDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testRoutesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEntityListed("data.kong_routes.routes", "routes", "name", "listed-route"),
+				),
+			},
+		},
+	})
+}
+
+const testRoutesDataSourceConfig = `
+resource "kong_service" "service" {
+	name     = "test"
+	protocol = "http"
+	host     = "test.org"
+}
+
+resource "kong_route" "route" {
+	name       = "listed-route"
+	protocols  = [ "http" ]
+	paths      = [ "/listed" ]
+	service_id = "${kong_service.service.id}"
+}
+
+data "kong_routes" "routes" {
+	depends_on = ["kong_route.route"]
+}
+`
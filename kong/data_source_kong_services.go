@@ -0,0 +1,136 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongServices() *schema.Resource {
+	dataSourceSchema := tagsFilterSchema()
+	dataSourceSchema["services"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"protocol": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"host": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"port": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"path": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"retries": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"connect_timeout": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"write_timeout": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"read_timeout": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"tags": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKongServicesRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceKongServicesRead(d *schema.ResourceData, meta interface{}) error {
+
+	entities, id, err := listEntitiesByTags(d, meta, "/services")
+
+	if err != nil {
+		return fmt.Errorf("could not list kong services: %v", err)
+	}
+
+	services := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		service := &gokong.Service{}
+		if err := json.Unmarshal(entity, service); err != nil {
+			return fmt.Errorf("could not parse kong service: %v", err)
+		}
+		services = append(services, flattenServiceEntity(service, readEntityTags(entity)))
+	}
+
+	d.SetId(id)
+	if err := d.Set("services", services); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenServiceEntity(in *gokong.Service, tags []string) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if in.Id != nil {
+		m["id"] = *in.Id
+	}
+	if in.Name != nil {
+		m["name"] = *in.Name
+	}
+	if in.Protocol != nil {
+		m["protocol"] = *in.Protocol
+	}
+	if in.Host != nil {
+		m["host"] = *in.Host
+	}
+	if in.Port != nil {
+		m["port"] = *in.Port
+	}
+	if in.Path != nil {
+		m["path"] = *in.Path
+	}
+	if in.Retries != nil {
+		m["retries"] = *in.Retries
+	}
+	if in.ConnectTimeout != nil {
+		m["connect_timeout"] = *in.ConnectTimeout
+	}
+	if in.WriteTimeout != nil {
+		m["write_timeout"] = *in.WriteTimeout
+	}
+	if in.ReadTimeout != nil {
+		m["read_timeout"] = *in.ReadTimeout
+	}
+	m["tags"] = tags
+
+	return m
+}
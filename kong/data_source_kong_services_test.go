@@ -0,0 +1,136 @@
+package kong
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongServicesDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testServicesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEntityListed("data.kong_services.services", "services", "name", "service-one"),
+					testAccCheckKongEntityListed("data.kong_services.services", "services", "name", "service-two"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongServicesDataSourceWithTags(t *testing.T) {
+	skipIfKongVersionBelow(t, "1.1.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testServicesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					tagKongEntity("kong_service.one", "/services", "team-a", "public"),
+					tagKongEntity("kong_service.two", "/services", "team-b", "public"),
+				),
+			},
+			{
+				Config: testServicesDataSourceWithTagsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_services.all", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_services.all", "services.0.name", "service-one"),
+					resource.TestCheckResourceAttr("data.kong_services.all", "services.0.tags.#", "2"),
+					resource.TestCheckResourceAttr("data.kong_services.any", "services.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKongEntityListed checks one of the entities returned by a list data source has field set to value
+func testAccCheckKongEntityListed(resourceKey string, list string, field string, value string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes[list+".#"])
+		if err != nil {
+			return fmt.Errorf("could not read %s count: %v", list, err)
+		}
+
+		for i := 0; i < count; i++ {
+			if rs.Primary.Attributes[fmt.Sprintf("%s.%d.%s", list, i, field)] == value {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("no entity with %s %s found in %s", field, value, list)
+	}
+}
+
+// tagKongEntity sets tags on an entity directly through the admin api
+func tagKongEntity(resourceKey string, path string, tags ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		request := map[string]interface{}{"tags": tags}
+		if err := testAccProvider.Meta().(*config).adminApi.patch(path+"/"+rs.Primary.ID, request, nil); err != nil {
+			return fmt.Errorf("could not tag %s: %v", resourceKey, err)
+		}
+
+		return nil
+	}
+}
+
+const testServicesDataSourceConfig = `
+resource "kong_service" "one" {
+	name     = "service-one"
+	protocol = "http"
+	host     = "one.org"
+}
+
+resource "kong_service" "two" {
+	name     = "service-two"
+	protocol = "http"
+	host     = "two.org"
+}
+
+data "kong_services" "services" {
+	depends_on = ["kong_service.one", "kong_service.two"]
+}
+`
+const testServicesDataSourceWithTagsConfig = `
+resource "kong_service" "one" {
+	name     = "service-one"
+	protocol = "http"
+	host     = "one.org"
+}
+
+resource "kong_service" "two" {
+	name     = "service-two"
+	protocol = "http"
+	host     = "two.org"
+}
+
+data "kong_services" "all" {
+	tags       = ["team-a", "public"]
+	tags_match = "all"
+}
+
+data "kong_services" "any" {
+	tags       = ["team-a", "team-b"]
+	tags_match = "any"
+}
+`
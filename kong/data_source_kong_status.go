@@ -0,0 +1,72 @@
+package kong
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceKongStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"database_reachable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether kong can reach its database",
+			},
+			"total_requests": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_active": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_accepted": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_handled": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_reading": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_writing": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"connections_waiting": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKongStatusRead(d *schema.ResourceData, meta interface{}) error {
+
+	status, err := meta.(*config).adminClient.Status().Get()
+
+	if err != nil {
+		return fmt.Errorf("could not read kong status: %v", err)
+	}
+
+	// The status is a point in time snapshot so it gets a fresh id on every read
+	d.SetId(time.Now().UTC().String())
+	d.Set("database_reachable", status.Database.Reachable)
+	d.Set("total_requests", status.Server.TotalRequests)
+	d.Set("connections_active", status.Server.ConnectionsActive)
+	d.Set("connections_accepted", status.Server.ConnectionsAccepted)
+	d.Set("connections_handled", status.Server.ConnectionsHandled)
+	d.Set("connections_reading", status.Server.ConnectionsReading)
+	d.Set("connections_writing", status.Server.ConnectionsWriting)
+	d.Set("connections_waiting", status.Server.ConnectionsWaiting)
+
+	return nil
+}
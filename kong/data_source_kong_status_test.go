@@ -0,0 +1,29 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongStatusDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStatusDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kong_status.status", "database_reachable", "true"),
+					resource.TestCheckResourceAttrSet("data.kong_status.status", "total_requests"),
+					resource.TestCheckResourceAttrSet("data.kong_status.status", "connections_active"),
+					resource.TestCheckResourceAttrSet("data.kong_status.status", "connections_handled"),
+				),
+			},
+		},
+	})
+}
+
+const testStatusDataSourceConfig = `
+data "kong_status" "status" {}
+`
@@ -0,0 +1,94 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongUpstreamHealth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKongUpstreamHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			"upstream_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"targets": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"target": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"health": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "One of HEALTHY, UNHEALTHY, DNS_ERROR or HEALTHCHECKS_OFF",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKongUpstreamHealthRead(d *schema.ResourceData, meta interface{}) error {
+
+	upstreamId := readStringFromResource(d, "upstream_id")
+
+	// gokong does not follow the next page when listing target health so we page through it ourselves
+	entries, err := meta.(*config).adminApi.list(fmt.Sprintf("/upstreams/%s/health", upstreamId))
+
+	if err != nil {
+		return fmt.Errorf("could not read kong upstream health: %v", err)
+	}
+
+	targets := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		target := &gokong.Target{}
+		if err := json.Unmarshal(entry, target); err != nil {
+			return fmt.Errorf("could not parse kong upstream health: %v", err)
+		}
+		targets = append(targets, flattenTargetHealth(target))
+	}
+
+	d.SetId(upstreamId)
+	if err := d.Set("targets", targets); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenTargetHealth(in *gokong.Target) map[string]interface{} {
+	m := make(map[string]interface{})
+
+	if in.Id != nil {
+		m["id"] = *in.Id
+	}
+	if in.Target != nil {
+		m["target"] = *in.Target
+	}
+	if in.Weight != nil {
+		m["weight"] = *in.Weight
+	}
+	if in.Health != nil {
+		m["health"] = *in.Health
+	}
+
+	return m
+}
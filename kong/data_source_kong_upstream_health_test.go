@@ -0,0 +1,43 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongUpstreamHealthDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testUpstreamHealthDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.kong_upstream_health.health", "upstream_id", "kong_upstream.upstream", "id"),
+					resource.TestCheckResourceAttr("data.kong_upstream_health.health", "targets.#", "1"),
+					resource.TestCheckResourceAttr("data.kong_upstream_health.health", "targets.0.target", "mytarget:4000"),
+					resource.TestCheckResourceAttr("data.kong_upstream_health.health", "targets.0.weight", "100"),
+					resource.TestCheckResourceAttr("data.kong_upstream_health.health", "targets.0.health", "HEALTHCHECKS_OFF"),
+				),
+			},
+		},
+	})
+}
+
+const testUpstreamHealthDataSourceConfig = `
+resource "kong_upstream" "upstream" {
+	name				= "MyUpstream"
+	slots				= 10
+}
+
+resource "kong_target" "target" {
+	target			= "mytarget:4000"
+	weight			= 100
+	upstream_id	= "${kong_upstream.upstream.id}"
+}
+
+data "kong_upstream_health" "health" {
+	upstream_id = "${kong_target.target.upstream_id}"
+}
+`
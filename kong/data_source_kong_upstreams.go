@@ -0,0 +1,107 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+func dataSourceKongUpstreams() *schema.Resource {
+	dataSourceSchema := tagsFilterSchema()
+	dataSourceSchema["upstreams"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"slots": &schema.Schema{
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"hash_on": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hash_fallback": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hash_on_header": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hash_fallback_header": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hash_on_cookie": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"hash_on_cookie_path": &schema.Schema{
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tags": &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceKongUpstreamsRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceKongUpstreamsRead(d *schema.ResourceData, meta interface{}) error {
+
+	entities, id, err := listEntitiesByTags(d, meta, "/upstreams")
+
+	if err != nil {
+		return fmt.Errorf("could not list kong upstreams: %v", err)
+	}
+
+	upstreams := make([]interface{}, 0, len(entities))
+	for _, entity := range entities {
+		upstream := &gokong.Upstream{}
+		if err := json.Unmarshal(entity, upstream); err != nil {
+			return fmt.Errorf("could not parse kong upstream: %v", err)
+		}
+
+		m := make(map[string]interface{})
+		m["id"] = upstream.Id
+		m["name"] = upstream.Name
+		m["slots"] = upstream.Slots
+		m["hash_on"] = upstream.HashOn
+		m["hash_fallback"] = upstream.HashFallback
+		m["hash_on_header"] = upstream.HashOnHeader
+		m["hash_fallback_header"] = upstream.HashFallbackHeader
+		m["hash_on_cookie"] = upstream.HashOnCookie
+		m["hash_on_cookie_path"] = upstream.HashOnCookiePath
+		m["tags"] = readEntityTags(entity)
+
+		upstreams = append(upstreams, m)
+	}
+
+	d.SetId(id)
+	if err := d.Set("upstreams", upstreams); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKongUpstreamsDataSource(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testUpstreamsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEntityListed("data.kong_upstreams.upstreams", "upstreams", "name", "ListedUpstream"),
+					testAccCheckKongEntityListed("data.kong_upstreams.upstreams", "upstreams", "slots", "20"),
+				),
+			},
+		},
+	})
+}
+
+const testUpstreamsDataSourceConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "ListedUpstream"
+	slots = 20
+}
+
+data "kong_upstreams" "upstreams" {
+	depends_on = ["kong_upstream.upstream"]
+}
+`
@@ -2,6 +2,7 @@ package kong
 
 import (
 	"os"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -11,11 +12,14 @@ import (
 
 type config struct {
 	adminClient           *gokong.KongAdminClient
+	adminApi              *adminApiClient
 	strictPlugins         bool
 	strictConsumerPlugins bool
 	upsertResources       bool
 	retryOnError          bool
 	retryTimeout          time.Duration
+	vaultsPath            string
+	vaultsPathLock        sync.Mutex
 }
 
 func Provider() terraform.ResourceProvider {
@@ -85,24 +89,50 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"kong_certificate":            resourceKongCertificate(),
-			"kong_consumer":               resourceKongConsumer(),
-			"kong_consumer_plugin_config": resourceKongConsumerPluginConfig(),
-			"kong_plugin":                 resourceKongPlugin(),
-			"kong_sni":                    resourceKongSni(),
-			"kong_upstream":               resourceKongUpstream(),
-			"kong_target":                 resourceKongTarget(),
-			"kong_service":                resourceKongService(),
-			"kong_route":                  resourceKongRoute(),
+			"kong_certificate":                   resourceKongCertificate(),
+			"kong_consumer":                      resourceKongConsumer(),
+			"kong_consumer_plugin_config":        resourceKongConsumerPluginConfig(),
+			"kong_plugin":                        resourceKongPlugin(),
+			"kong_sni":                           resourceKongSni(),
+			"kong_upstream":                      resourceKongUpstream(),
+			"kong_target":                        resourceKongTarget(),
+			"kong_upstream_targets":              resourceKongUpstreamTargets(),
+			"kong_service":                       resourceKongService(),
+			"kong_route":                         resourceKongRoute(),
+			"kong_ca_certificate":                resourceKongCaCertificate(),
+			"kong_consumer_key_auth":             resourceKongConsumerKeyAuth(),
+			"kong_consumer_basic_auth":           resourceKongConsumerBasicAuth(),
+			"kong_consumer_jwt":                  resourceKongConsumerJwt(),
+			"kong_consumer_hmac_auth":            resourceKongConsumerHmacAuth(),
+			"kong_consumer_oauth2":               resourceKongConsumerOAuth2(),
+			"kong_consumer_acl":                  resourceKongConsumerAcl(),
+			"kong_consumer_group":                resourceKongConsumerGroup(),
+			"kong_consumer_group_member":         resourceKongConsumerGroupMember(),
+			"kong_vault":                         resourceKongVault(),
+			"kong_key_set":                       resourceKongKeySet(),
+			"kong_key":                           resourceKongKey(),
+			"kong_workspace":                     resourceKongWorkspace(),
+			"kong_rbac_user":                     resourceKongRbacUser(),
+			"kong_rbac_role":                     resourceKongRbacRole(),
+			"kong_rbac_user_role":                resourceKongRbacUserRole(),
+			"kong_rbac_role_endpoint_permission": resourceKongRbacRoleEndpointPermission(),
+			"kong_rbac_role_entity_permission":   resourceKongRbacRoleEntityPermission(),
+			"kong_license":                       resourceKongLicense(),
+			"kong_event_hook":                    resourceKongEventHook(),
 		},
 
-		//DataSourcesMap: map[string]*schema.Resource{
-		//	"kong_api":         dataSourceKongApi(),
-		//	"kong_certificate": dataSourceKongCertificate(),
-		//	"kong_consumer":    dataSourceKongConsumer(),
-		//	"kong_plugin":      dataSourceKongPlugin(),
-		//	"kong_upstream":    dataSourceKongUpstream(),
-		//},
+		DataSourcesMap: map[string]*schema.Resource{
+			"kong_certificate":     dataSourceKongCertificate(),
+			"kong_node_info":       dataSourceKongNodeInfo(),
+			"kong_status":          dataSourceKongStatus(),
+			"kong_plugin_schema":   dataSourceKongPluginSchema(),
+			"kong_upstream_health": dataSourceKongUpstreamHealth(),
+			"kong_services":        dataSourceKongServices(),
+			"kong_routes":          dataSourceKongRoutes(),
+			"kong_consumers":       dataSourceKongConsumers(),
+			"kong_upstreams":       dataSourceKongUpstreams(),
+			"kong_plugins":         dataSourceKongPlugins(),
+		},
 		ConfigureFunc: providerConfigure,
 	}
 }
@@ -133,6 +163,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	config := &config{
 		adminClient:     gokong.NewClient(kongConfig),
+		adminApi:        newAdminApiClient(kongConfig),
 		strictPlugins:   d.Get("strict_plugins_match").(bool),
 		upsertResources: d.Get("upsert_resources").(bool),
 		retryOnError:    d.Get("retry_on_error").(bool),
@@ -5,6 +5,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/kevholditch/gokong"
@@ -56,6 +57,31 @@ func TestProvider_configure_strict(t *testing.T) {
 	}
 }
 
+// skipIfKongVersionBelow skips tests of features the kong version under test does not have, e.g. tags need 1.1
+func skipIfKongVersionBelow(t *testing.T, minimum string) {
+	current := version.Must(version.NewVersion(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion)))
+	if current.LessThan(version.Must(version.NewVersion(minimum))) {
+		t.Skipf("kong %s does not support this feature, it needs %s or later", current, minimum)
+	}
+}
+
+// skipUnlessKongVersionBelow skips tests of behaviour that newer versions of kong replaced, e.g. targets being updated
+// by adding a new entry before 2.2
+func skipUnlessKongVersionBelow(t *testing.T, maximum string) {
+	current := version.Must(version.NewVersion(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion)))
+	if !current.LessThan(version.Must(version.NewVersion(maximum))) {
+		t.Skipf("kong %s no longer behaves this way, it changed in %s", current, maximum)
+	}
+}
+
+// skipUnlessKongEnterprise skips tests of enterprise only features such as workspaces and RBAC, set KONG_EDITION to
+// enterprise when testing against a Kong Enterprise image
+func skipUnlessKongEnterprise(t *testing.T) {
+	if GetEnvVarOrDefault("KONG_EDITION", "community") != "enterprise" {
+		t.Skip("this feature needs kong enterprise, set KONG_EDITION=enterprise to test it")
+	}
+}
+
 func TestMain(m *testing.M) {
 
 	testContext := containers.StartKong(GetEnvVarOrDefault("KONG_VERSION", defaultKongVersion))
@@ -0,0 +1,60 @@
+package kong
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+var rbacActions = []string{"read", "create", "update", "delete"}
+
+// Kong takes the actions of a permission as a comma separated string and returns them as a list
+type rbacEndpointPermissionRequest struct {
+	Workspace string `json:"workspace,omitempty"`
+	Endpoint  string `json:"endpoint,omitempty"`
+	Actions   string `json:"actions"`
+	Negative  bool   `json:"negative"`
+}
+
+type rbacEndpointPermission struct {
+	Workspace string   `json:"workspace"`
+	Endpoint  string   `json:"endpoint"`
+	Actions   []string `json:"actions"`
+	Negative  bool     `json:"negative"`
+}
+
+type rbacEntityPermissionRequest struct {
+	EntityId   string `json:"entity_id,omitempty"`
+	EntityType string `json:"entity_type,omitempty"`
+	Actions    string `json:"actions"`
+}
+
+type rbacEntityPermission struct {
+	EntityId   string   `json:"entity_id"`
+	EntityType string   `json:"entity_type"`
+	Actions    []string `json:"actions"`
+}
+
+func rbacActionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		ForceNew: false,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(rbacActions, false),
+		},
+	}
+}
+
+func readRbacActionsFromResource(d *schema.ResourceData) string {
+	var actions []string
+	for _, action := range d.Get("actions").(*schema.Set).List() {
+		actions = append(actions, action.(string))
+	}
+	sort.Strings(actions)
+	return strings.Join(actions, ",")
+}
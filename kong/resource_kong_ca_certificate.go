@@ -0,0 +1,138 @@
+package kong
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const caCertificatesPath = "/ca_certificates/"
+
+type caCertificateRequest struct {
+	Cert string   `json:"cert"`
+	Tags []string `json:"tags"`
+}
+
+type caCertificate struct {
+	Id         string   `json:"id"`
+	Cert       string   `json:"cert"`
+	CertDigest string   `json:"cert_digest"`
+	Tags       []string `json:"tags"`
+}
+
+func resourceKongCaCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongCaCertificateCreate,
+		Read:   resourceKongCaCertificateRead,
+		Delete: resourceKongCaCertificateDelete,
+		Update: resourceKongCaCertificateUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cert": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateCaCertificate,
+			},
+			"tags": tagsSchema(),
+			"cert_digest": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateCaCertificate(certI interface{}, k string) ([]string, []error) {
+	certificate, err := parsePemCertificate(certI.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s is not a valid certificate: %v", k, err)}
+	}
+
+	if !certificate.BasicConstraintsValid || !certificate.IsCA {
+		return nil, []error{fmt.Errorf("%s is not a CA certificate, it must have the basic constraint CA:TRUE", k)}
+	}
+
+	return nil, nil
+}
+
+func resourceKongCaCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+
+	caCertificateRequest := createKongCaCertificateRequestFromResourceData(d)
+
+	caCertificate := &caCertificate{}
+	err := meta.(*config).adminApi.post(caCertificatesPath, caCertificateRequest, caCertificate)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong ca certificate: %v error: %v", caCertificateRequest, err)
+	}
+
+	d.SetId(caCertificate.Id)
+
+	return resourceKongCaCertificateRead(d, meta)
+}
+
+func resourceKongCaCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	caCertificateRequest := createKongCaCertificateRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(caCertificatesPath+d.Id(), caCertificateRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong ca certificate: %s", err)
+	}
+
+	return resourceKongCaCertificateRead(d, meta)
+}
+
+func resourceKongCaCertificateRead(d *schema.ResourceData, meta interface{}) error {
+
+	caCertificate := &caCertificate{}
+	found, err := meta.(*config).adminApi.get(caCertificatesPath+d.Id(), caCertificate)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong ca certificate: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+	} else {
+		d.Set("cert", caCertificate.Cert)
+		d.Set("tags", caCertificate.Tags)
+
+		// Kong only returns the digest from 2.0 onwards, it is the sha256 of the DER encoded certificate
+		if caCertificate.CertDigest == "" {
+			if certificate, err := parsePemCertificate(caCertificate.Cert); err == nil {
+				digest := sha256.Sum256(certificate.Raw)
+				caCertificate.CertDigest = hex.EncodeToString(digest[:])
+			}
+		}
+		d.Set("cert_digest", caCertificate.CertDigest)
+	}
+
+	return nil
+}
+
+func resourceKongCaCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(caCertificatesPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong ca certificate: %v", err)
+	}
+
+	return nil
+}
+
+func createKongCaCertificateRequestFromResourceData(d *schema.ResourceData) *caCertificateRequest {
+	return &caCertificateRequest{
+		Cert: readStringFromResource(d, "cert"),
+		Tags: readTagsFromResource(d),
+	}
+}
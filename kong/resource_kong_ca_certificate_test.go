@@ -0,0 +1,186 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongCaCertificate(t *testing.T) {
+	skipIfKongVersionBelow(t, "1.3.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCaCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateCaCertificateConfig, testCaCert1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCaCertificateExists("kong_ca_certificate.ca"),
+					resource.TestCheckResourceAttr("kong_ca_certificate.ca", "cert", testCaCert1+"\n"),
+					resource.TestCheckResourceAttr("kong_ca_certificate.ca", "tags.#", "1"),
+					resource.TestCheckResourceAttrSet("kong_ca_certificate.ca", "cert_digest"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testUpdateCaCertificateConfig, testCaCert2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongCaCertificateExists("kong_ca_certificate.ca"),
+					resource.TestCheckResourceAttr("kong_ca_certificate.ca", "cert", testCaCert2+"\n"),
+					resource.TestCheckResourceAttr("kong_ca_certificate.ca", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongCaCertificateImport(t *testing.T) {
+	skipIfKongVersionBelow(t, "1.3.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongCaCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testCreateCaCertificateConfig, testCaCert1),
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_ca_certificate.ca",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongCaCertificateRejectsLeafCertificate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testCreateCaCertificateConfig, testCert1),
+				ExpectError: regexp.MustCompile("is not a CA certificate"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongCaCertificateDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	caCertificates := getResourcesByType("kong_ca_certificate", state)
+
+	if len(caCertificates) != 1 {
+		return fmt.Errorf("expecting only 1 ca certificate resource found %v", len(caCertificates))
+	}
+
+	found, err := client.get(caCertificatesPath+caCertificates[0].Primary.ID, &caCertificate{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get ca certificate by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("ca certificate %s still exists", caCertificates[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongCaCertificateExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(caCertificatesPath+rs.Primary.ID, &caCertificate{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("ca certificate with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateCaCertificateConfig = `
+resource "kong_ca_certificate" "ca" {
+	cert = <<EOF
+%s
+EOF
+	tags = ["trust"]
+}
+`
+const testUpdateCaCertificateConfig = `
+resource "kong_ca_certificate" "ca" {
+	cert = <<EOF
+%s
+EOF
+	tags = ["trust", "rotated"]
+}
+`
+
+const (
+	testCaCert1 = `-----BEGIN CERTIFICATE-----
+MIIDpzCCAo+gAwIBAgIUNUpJYd+jSUgD2uTaR4m6c+87DwowDQYJKoZIhvcNAQEL
+BQAwWjELMAkGA1UEBhMCR0IxDTALBgNVBAgMBENBTUIxEjAQBgNVBAcMCUNhbWJy
+aWRnZTEUMBIGA1UECgwLa2V2aG9sZGl0Y2gxEjAQBgNVBAMMCWdva29uZy1jYTAg
+Fw0yNjEwMTgyMTEwMjVaGA8yMTI2MDkyNDIxMTAyNVowWjELMAkGA1UEBhMCR0Ix
+DTALBgNVBAgMBENBTUIxEjAQBgNVBAcMCUNhbWJyaWRnZTEUMBIGA1UECgwLa2V2
+aG9sZGl0Y2gxEjAQBgNVBAMMCWdva29uZy1jYTCCASIwDQYJKoZIhvcNAQEBBQAD
+ggEPADCCAQoCggEBAKWSCrrx0s79ioUD3Bnbz21aInYluyRl9g2cUJvGJ6HDDO/U
+d39oh8irSW1xqOWAp3X56T1uv5GJNQdIc2vkwbKnOxjhBBamLaH77bGmdxKdIxx/
+ze7wfpSZ9nhsnp2Sw++BPrWIX/KBoW/eII/51sTHIf9bq8s/utCBDSxL8N75MrKq
+vryTxNUO/xdJe5sQ9mBNdqCdOg2b2tviTz3PBjlMlRUjwoHnkXPYHCsuJScD7Iw+
+uzsR2d8IPtLoJoT79T5QcoeApwr85hq5QTbf5W4cVRaD8QlQ1Jkvpm7ZtijC4QNL
+P3gy2InHwGVY7Zecw5A1LIKqiU3yXMkle5eWM8kCAwEAAaNjMGEwHQYDVR0OBBYE
+FAfgVlFbFmY6uT1X+ZlgdY68F7yQMB8GA1UdIwQYMBaAFAfgVlFbFmY6uT1X+Zlg
+dY68F7yQMA8GA1UdEwEB/wQFMAMBAf8wDgYDVR0PAQH/BAQDAgEGMA0GCSqGSIb3
+DQEBCwUAA4IBAQBKwocJsYLTcDotlyn6uYarsOMMJX+VxXZYZ3pQaTRWcLYhAGPL
+uwKEQK5c3hze9RKwkHePH1ayhOftjpHi+Pbb8TeFa9NoLpCGf5TCRJC8Uwwq1liB
+neC4N0HrQFQGRIW74+yF7jltgd47+WZ+i+OF+nkysB59Bb+EzjoiLRLyfi2N9TCn
+BIymNdAcnyZlXozzLMSvrACOdeN50T1YxfPvcJQzt6ZiAyW5jcS4jKOF4LFFeUZF
+uBbo/0jooM8vcNo7gKzenMf5/whayrYCWmYp8avTe355GgcJh1RkvNTibxA72lvm
+yu6n5vktvFdlfCdtnJ+HqTpggPnBLRkM4aF4
+-----END CERTIFICATE-----`
+
+	testCaCert2 = `-----BEGIN CERTIFICATE-----
+MIIDqzCCApOgAwIBAgIUPYYhvY0fcK4k8g8Wrr8OS1slud0wDQYJKoZIhvcNAQEL
+BQAwXDELMAkGA1UEBhMCR0IxDTALBgNVBAgMBENBTUIxEjAQBgNVBAcMCUNhbWJy
+aWRnZTEUMBIGA1UECgwLa2V2aG9sZGl0Y2gxFDASBgNVBAMMC2dva29uZy1jYS0y
+MCAXDTI2MTAxODIxMTAyOFoYDzIxMjYwOTI0MjExMDI4WjBcMQswCQYDVQQGEwJH
+QjENMAsGA1UECAwEQ0FNQjESMBAGA1UEBwwJQ2FtYnJpZGdlMRQwEgYDVQQKDAtr
+ZXZob2xkaXRjaDEUMBIGA1UEAwwLZ29rb25nLWNhLTIwggEiMA0GCSqGSIb3DQEB
+AQUAA4IBDwAwggEKAoIBAQDX+oINb6DM3NV6c0GkwXPV0r0LEIZHugxUHoNoXuLF
+qf6cqao2r05NoWTebMPbT36ZuoYS3tQvWRaAUl2sc6SobCPJHl3Z2HDbfm+3sRTp
+oxZTVjk6q5GFH0rTTL1VgG2N5evYABtm+zDvoJ1qDfXVfZBEQi+sTdY1y44POMBC
+5+oDHlmU35hmzFCpHy0od4IvMPnE4MOKcUAKXWSeAvvZz9i+rRKAoCI/ZYMu9xQq
+pHcd0KDxs1XkkpK8jbHYgDwR0GWypgWKF/ZMHMSAvFmIpIonfVNS3JdkV9hC3dmJ
+VYx1eMRxDIFJBVjZqilKAfDqIeM6lE7po/57D7NH9w6zAgMBAAGjYzBhMB0GA1Ud
+DgQWBBQtg6ZPspXpTdMNDygki18zqhcjijAfBgNVHSMEGDAWgBQtg6ZPspXpTdMN
+Dygki18zqhcjijAPBgNVHRMBAf8EBTADAQH/MA4GA1UdDwEB/wQEAwIBBjANBgkq
+hkiG9w0BAQsFAAOCAQEAorxsDxC7lNSjuWZ+Hxw627WqRhQ0w0rw7WrN9sYHAQxO
+r0lxVmxT1xcEGVtO5NquQuX95YU/ZLNfZicaGDxzFTfLwKn2WQC8CNisYQlCU2JQ
+hDOHAANm7X13keXMCc//slQqSI7cqIBl+W3xfXkD6G5lj4Vdith1iIP6233J6lm6
+6orcYXSZ/X458zoocAm3qTbRCxJYJYfeszpguVLAz4sXJL4SvgGE37WnwiESg8zc
+o8BxplA58ObbexFIgPYm4YA+ZAgR+2L3UT43XDYGZIPRccXG4NiKjLdLZPoqBGPx
+7PFJjXUByiyLjo4MnhDYbGs9gHT4lsz102jkZ8yQdA==
+-----END CERTIFICATE-----`
+)
@@ -0,0 +1,109 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const aclPluginName = "acls"
+
+type aclGroupRequest struct {
+	Group string    `json:"group,omitempty"`
+	Tags  *[]string `json:"tags,omitempty"`
+}
+
+type aclGroup struct {
+	Id    string   `json:"id"`
+	Group string   `json:"group"`
+	Tags  []string `json:"tags"`
+}
+
+func resourceKongConsumerAcl() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerAclCreate,
+		Read:   resourceKongConsumerAclRead,
+		Delete: resourceKongConsumerAclDelete,
+		Update: resourceKongConsumerAclUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongConsumerAclCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	aclRequest := createKongConsumerAclRequestFromResourceData(d)
+
+	acl := &aclGroup{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, aclPluginName, ""), aclRequest, acl)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer acl for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, acl.Id))
+
+	return resourceKongConsumerAclRead(d, meta)
+}
+
+func resourceKongConsumerAclUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	aclRequest := createKongConsumerAclRequestFromResourceData(d)
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, aclPluginName, credentialId), aclRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer acl: %s", err)
+	}
+
+	return resourceKongConsumerAclRead(d, meta)
+}
+
+func resourceKongConsumerAclRead(d *schema.ResourceData, meta interface{}) error {
+
+	acl := &aclGroup{}
+	found, err := readConsumerCredential(d, meta, aclPluginName, acl)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("group", acl.Group)
+	d.Set("tags", acl.Tags)
+
+	return nil
+}
+
+func resourceKongConsumerAclDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, aclPluginName)
+}
+
+func createKongConsumerAclRequestFromResourceData(d *schema.ResourceData) *aclGroupRequest {
+	return &aclGroupRequest{
+		Group: readStringFromResource(d, "group"),
+		Tags:  readTagsPtrFromResource(d),
+	}
+}
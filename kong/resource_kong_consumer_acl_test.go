@@ -0,0 +1,86 @@
+package kong
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerAcl(t *testing.T) {
+
+	var aclId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerAclDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerAclExists("kong_consumer_acl.acl"),
+					resource.TestCheckResourceAttr("kong_consumer_acl.acl", "group", "readers"),
+					storeResourceId("kong_consumer_acl.acl", &aclId),
+				),
+			},
+			{
+				Config: testUpdateConsumerAclConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerAclExists("kong_consumer_acl.acl"),
+					resource.TestCheckResourceAttr("kong_consumer_acl.acl", "group", "writers"),
+					checkResourceId("kong_consumer_acl.acl", &aclId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerAclImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerAclDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateConsumerAclConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_acl.acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerAclDestroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_acl", aclPluginName)
+}
+
+func testAccCheckKongConsumerAclExists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, aclPluginName)
+}
+
+const testCreateConsumerAclConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "AclUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_acl" "acl" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	group       = "readers"
+}
+`
+const testUpdateConsumerAclConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "AclUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_acl" "acl" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	group       = "writers"
+}
+`
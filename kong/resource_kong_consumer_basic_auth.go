@@ -0,0 +1,161 @@
+package kong
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const basicAuthPluginName = "basic-auth"
+
+type basicAuthCredentialRequest struct {
+	Username string    `json:"username,omitempty"`
+	Password string    `json:"password,omitempty"`
+	Tags     *[]string `json:"tags,omitempty"`
+}
+
+type basicAuthCredential struct {
+	Id       string   `json:"id"`
+	Username string   `json:"username"`
+	Tags     []string `json:"tags"`
+}
+
+func resourceKongConsumerBasicAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerBasicAuthCreate,
+		Read:   resourceKongConsumerBasicAuthRead,
+		Delete: resourceKongConsumerBasicAuthDelete,
+		Update: resourceKongConsumerBasicAuthUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			// Kong only returns the hash of the password so it can never be read back. Instead we keep our own salted
+			// hash of the configured password in state and compare the configuration against it.
+			"password": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         false,
+				Sensitive:        true,
+				StateFunc:        hashBasicAuthPassword,
+				DiffSuppressFunc: basicAuthPasswordUnchanged,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// hashBasicAuthPassword returns salt$sha256(salt + password), the salt is random so identical passwords do not
+// produce identical state.
+func hashBasicAuthPassword(passwordI interface{}) string {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		// Should never happen.
+		log.Printf("[ERROR] Could not generate salt for basic auth password: %s", err)
+	}
+
+	return saltedPasswordHash(hex.EncodeToString(salt), passwordI.(string))
+}
+
+func saltedPasswordHash(salt string, password string) string {
+	hash := sha256.Sum256([]byte(salt + password))
+	return salt + "$" + hex.EncodeToString(hash[:])
+}
+
+func basicAuthPasswordUnchanged(k, old, new string, d *schema.ResourceData) bool {
+	salt := strings.Split(old, "$")[0]
+	if salt == "" || salt == old {
+		// Nothing in state yet, e.g. just after an import
+		return false
+	}
+
+	// The new value has already been hashed with a fresh salt so the configured password is read instead
+	password := d.Get(k).(string)
+
+	return subtle.ConstantTimeCompare([]byte(saltedPasswordHash(salt, password)), []byte(old)) == 1
+}
+
+func resourceKongConsumerBasicAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	basicAuthRequest := &basicAuthCredentialRequest{
+		Username: readStringFromResource(d, "username"),
+		Password: readStringFromResource(d, "password"),
+		Tags:     readTagsPtrFromResource(d),
+	}
+
+	basicAuth := &basicAuthCredential{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, basicAuthPluginName, ""), basicAuthRequest, basicAuth)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer basic auth for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, basicAuth.Id))
+
+	return resourceKongConsumerBasicAuthRead(d, meta)
+}
+
+func resourceKongConsumerBasicAuthUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	basicAuthRequest := &basicAuthCredentialRequest{
+		Username: readStringFromResource(d, "username"),
+		Tags:     readTagsPtrFromResource(d),
+	}
+
+	// When unchanged the password in state is our hash, it must never be sent to kong
+	if d.HasChange("password") {
+		basicAuthRequest.Password = readStringFromResource(d, "password")
+	}
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, basicAuthPluginName, credentialId), basicAuthRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer basic auth: %s", err)
+	}
+
+	return resourceKongConsumerBasicAuthRead(d, meta)
+}
+
+func resourceKongConsumerBasicAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	basicAuth := &basicAuthCredential{}
+	found, err := readConsumerCredential(d, meta, basicAuthPluginName, basicAuth)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("username", basicAuth.Username)
+	d.Set("tags", basicAuth.Tags)
+
+	return nil
+}
+
+func resourceKongConsumerBasicAuthDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, basicAuthPluginName)
+}
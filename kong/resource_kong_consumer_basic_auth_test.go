@@ -0,0 +1,80 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerBasicAuth(t *testing.T) {
+
+	var credentialId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerBasicAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testConsumerBasicAuthConfig, "partner", "first-password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerBasicAuthExists("kong_consumer_basic_auth.basic_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_basic_auth.basic_auth", "username", "partner"),
+					resource.TestMatchResourceAttr("kong_consumer_basic_auth.basic_auth", "password", regexp.MustCompile(`^[0-9a-f]{32}\$[0-9a-f]{64}$`)),
+					storeResourceId("kong_consumer_basic_auth.basic_auth", &credentialId),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testConsumerBasicAuthConfig, "partner-renamed", "second-password"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerBasicAuthExists("kong_consumer_basic_auth.basic_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_basic_auth.basic_auth", "username", "partner-renamed"),
+					checkResourceId("kong_consumer_basic_auth.basic_auth", &credentialId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerBasicAuthImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerBasicAuthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testConsumerBasicAuthConfig, "partner", "first-password"),
+			},
+
+			resource.TestStep{
+				ResourceName:            "kong_consumer_basic_auth.basic_auth",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerBasicAuthDestroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_basic_auth", basicAuthPluginName)
+}
+
+func testAccCheckKongConsumerBasicAuthExists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, basicAuthPluginName)
+}
+
+const testConsumerBasicAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "BasicAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_basic_auth" "basic_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "%s"
+	password    = "%s"
+}
+`
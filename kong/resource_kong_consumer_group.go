@@ -0,0 +1,139 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const consumerGroupsPath = "/consumer_groups/"
+
+type consumerGroupRequest struct {
+	Name string    `json:"name,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+type consumerGroup struct {
+	Id   string   `json:"id"`
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+// consumerGroupResponse is what kong returns when fetching a single group, the group is wrapped together with its
+// members
+type consumerGroupResponse struct {
+	ConsumerGroup *consumerGroup `json:"consumer_group"`
+	Consumers     []struct {
+		Id string `json:"id"`
+	} `json:"consumers"`
+}
+
+func resourceKongConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerGroupCreate,
+		Read:   resourceKongConsumerGroupRead,
+		Delete: resourceKongConsumerGroupDelete,
+		Update: resourceKongConsumerGroupUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerGroupRequest := createKongConsumerGroupRequestFromResourceData(d)
+
+	group := &consumerGroup{}
+	err := meta.(*config).adminApi.post(consumerGroupsPath, consumerGroupRequest, group)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer group: %v error: %v", consumerGroupRequest, err)
+	}
+
+	d.SetId(group.Id)
+
+	return resourceKongConsumerGroupRead(d, meta)
+}
+
+func resourceKongConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerGroupRequest := createKongConsumerGroupRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(consumerGroupsPath+d.Id(), consumerGroupRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer group: %s", err)
+	}
+
+	return resourceKongConsumerGroupRead(d, meta)
+}
+
+func resourceKongConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+
+	group, _, err := getConsumerGroup(meta, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong consumer group: %v", err)
+	}
+
+	if group == nil {
+		d.SetId("")
+	} else {
+		d.Set("name", group.Name)
+		d.Set("tags", group.Tags)
+	}
+
+	return nil
+}
+
+func resourceKongConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(consumerGroupsPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong consumer group: %v", err)
+	}
+
+	return nil
+}
+
+// getConsumerGroup returns the group with the given id or name and the ids of its members, the group is nil when it
+// does not exist.
+func getConsumerGroup(meta interface{}, idOrName string) (*consumerGroup, []string, error) {
+
+	response := &consumerGroupResponse{}
+	found, err := meta.(*config).adminApi.get(consumerGroupsPath+idOrName, response)
+
+	if err != nil || !found {
+		return nil, nil, err
+	}
+
+	if response.ConsumerGroup == nil {
+		return nil, nil, fmt.Errorf("unexpected response from kong for consumer group %s", idOrName)
+	}
+
+	consumerIds := make([]string, 0, len(response.Consumers))
+	for _, consumer := range response.Consumers {
+		consumerIds = append(consumerIds, consumer.Id)
+	}
+
+	return response.ConsumerGroup, consumerIds, nil
+}
+
+func createKongConsumerGroupRequestFromResourceData(d *schema.ResourceData) *consumerGroupRequest {
+	return &consumerGroupRequest{
+		Name: readStringFromResource(d, "name"),
+		Tags: readTagsPtrFromResource(d),
+	}
+}
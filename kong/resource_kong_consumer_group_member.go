@@ -0,0 +1,110 @@
+package kong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type consumerGroupMemberRequest struct {
+	Consumer string `json:"consumer"`
+}
+
+func resourceKongConsumerGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerGroupMemberCreate,
+		Read:   resourceKongConsumerGroupMemberRead,
+		Delete: resourceKongConsumerGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKongConsumerGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerGroupId := readStringFromResource(d, "consumer_group_id")
+	consumerId := readStringFromResource(d, "consumer_id")
+
+	err := meta.(*config).adminApi.post(consumerGroupMembersPath(consumerGroupId, ""), &consumerGroupMemberRequest{Consumer: consumerId}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add kong consumer: %s to consumer group: %s error: %v", consumerId, consumerGroupId, err)
+	}
+
+	d.SetId(consumerGroupId + "/" + consumerId)
+
+	return resourceKongConsumerGroupMemberRead(d, meta)
+}
+
+func resourceKongConsumerGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+
+	consumerGroupId, consumerId, err := splitConsumerGroupMemberId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	group, consumerIds, err := getConsumerGroup(meta, consumerGroupId)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong consumer group member: %v", err)
+	}
+
+	if group == nil || !contains(consumerIds, consumerId) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("consumer_group_id", consumerGroupId)
+	d.Set("consumer_id", consumerId)
+
+	return nil
+}
+
+func resourceKongConsumerGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+
+	consumerGroupId, consumerId, err := splitConsumerGroupMemberId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*config).adminApi.delete(consumerGroupMembersPath(consumerGroupId, consumerId)); err != nil {
+		return fmt.Errorf("could not delete kong consumer group member: %v", err)
+	}
+
+	return nil
+}
+
+func consumerGroupMembersPath(consumerGroupId string, consumerId string) string {
+	path := consumerGroupsPath + consumerGroupId + "/consumers"
+	if consumerId != "" {
+		path += "/" + consumerId
+	}
+	return path
+}
+
+func splitConsumerGroupMemberId(id string) (string, string, error) {
+	idSplit := strings.Split(id, "/")
+
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("failed to calculate consumer group member id, should be slash separated as consumerGroupId/consumerId found: %v", id)
+	}
+
+	return idSplit[0], idSplit[1], nil
+}
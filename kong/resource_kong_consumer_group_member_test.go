@@ -0,0 +1,130 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerGroupMember(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+	skipIfKongVersionBelow(t, "3.4.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerGroupMemberConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerGroupMemberExists("kong_consumer_group_member.member"),
+					testAccCheckForChildIdCorrect("kong_consumer_group.group", "kong_consumer_group_member.member", "consumer_group_id"),
+					testAccCheckForChildIdCorrect("kong_consumer.consumer", "kong_consumer_group_member.member", "consumer_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerGroupMemberImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+	skipIfKongVersionBelow(t, "3.4.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateConsumerGroupMemberConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_group_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerGroupMemberDestroy(state *terraform.State) error {
+
+	members := getResourcesByType("kong_consumer_group_member", state)
+
+	if len(members) != 1 {
+		return fmt.Errorf("expecting only 1 consumer group member resource found %v", len(members))
+	}
+
+	found, err := consumerGroupMemberExists(members[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get consumer group by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("consumer group member %s still exists", members[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerGroupMemberExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := consumerGroupMemberExists(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("consumer group member with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func consumerGroupMemberExists(id string) (bool, error) {
+	consumerGroupId, consumerId, err := splitConsumerGroupMemberId(id)
+
+	if err != nil {
+		return false, err
+	}
+
+	group, consumerIds, err := getConsumerGroup(testAccProvider.Meta(), consumerGroupId)
+
+	if err != nil {
+		return false, err
+	}
+
+	return group != nil && contains(consumerIds, consumerId), nil
+}
+
+const testCreateConsumerGroupMemberConfig = `
+resource "kong_consumer_group" "group" {
+	name = "gold"
+}
+
+resource "kong_consumer" "consumer" {
+	username  = "GroupUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_group_member" "member" {
+	consumer_group_id = "${kong_consumer_group.group.id}"
+	consumer_id       = "${kong_consumer.consumer.id}"
+}
+`
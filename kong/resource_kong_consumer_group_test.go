@@ -0,0 +1,119 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerGroup(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+	skipIfKongVersionBelow(t, "3.4.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerGroupExists("kong_consumer_group.group"),
+					resource.TestCheckResourceAttr("kong_consumer_group.group", "name", "gold"),
+					resource.TestCheckResourceAttr("kong_consumer_group.group", "tags.#", "1"),
+				),
+			},
+			{
+				Config: testUpdateConsumerGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerGroupExists("kong_consumer_group.group"),
+					resource.TestCheckResourceAttr("kong_consumer_group.group", "name", "platinum"),
+					resource.TestCheckResourceAttr("kong_consumer_group.group", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerGroupImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+	skipIfKongVersionBelow(t, "3.4.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateConsumerGroupConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerGroupDestroy(state *terraform.State) error {
+
+	groups := getResourcesByType("kong_consumer_group", state)
+
+	if len(groups) != 1 {
+		return fmt.Errorf("expecting only 1 consumer group resource found %v", len(groups))
+	}
+
+	group, _, err := getConsumerGroup(testAccProvider.Meta(), groups[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get consumer group by id: %v", err)
+	}
+
+	if group != nil {
+		return fmt.Errorf("consumer group %s still exists, %+v", groups[0].Primary.ID, group)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerGroupExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		group, _, err := getConsumerGroup(testAccProvider.Meta(), rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if group == nil {
+			return fmt.Errorf("consumer group with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerGroupConfig = `
+resource "kong_consumer_group" "group" {
+	name = "gold"
+	tags = ["tier"]
+}
+`
+const testUpdateConsumerGroupConfig = `
+resource "kong_consumer_group" "group" {
+	name = "platinum"
+	tags = ["tier", "paid"]
+}
+`
@@ -0,0 +1,121 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const hmacAuthPluginName = "hmac-auth"
+
+type hmacAuthCredentialRequest struct {
+	Username string    `json:"username,omitempty"`
+	Secret   string    `json:"secret,omitempty"`
+	Tags     *[]string `json:"tags,omitempty"`
+}
+
+type hmacAuthCredential struct {
+	Id       string   `json:"id"`
+	Username string   `json:"username"`
+	Secret   string   `json:"secret"`
+	Tags     []string `json:"tags"`
+}
+
+func resourceKongConsumerHmacAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerHmacAuthCreate,
+		Read:   resourceKongConsumerHmacAuthRead,
+		Delete: resourceKongConsumerHmacAuthDelete,
+		Update: resourceKongConsumerHmacAuthUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"username": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			// Kong generates a secret when none is given, it is read back into state so it can be handed to the consumer
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongConsumerHmacAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	hmacAuthRequest := createKongConsumerHmacAuthRequestFromResourceData(d)
+
+	hmacAuth := &hmacAuthCredential{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, hmacAuthPluginName, ""), hmacAuthRequest, hmacAuth)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer hmac auth for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, hmacAuth.Id))
+
+	return resourceKongConsumerHmacAuthRead(d, meta)
+}
+
+func resourceKongConsumerHmacAuthUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	hmacAuthRequest := createKongConsumerHmacAuthRequestFromResourceData(d)
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, hmacAuthPluginName, credentialId), hmacAuthRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer hmac auth: %s", err)
+	}
+
+	return resourceKongConsumerHmacAuthRead(d, meta)
+}
+
+func resourceKongConsumerHmacAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	hmacAuth := &hmacAuthCredential{}
+	found, err := readConsumerCredential(d, meta, hmacAuthPluginName, hmacAuth)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("username", hmacAuth.Username)
+	d.Set("secret", hmacAuth.Secret)
+	d.Set("tags", hmacAuth.Tags)
+
+	return nil
+}
+
+func resourceKongConsumerHmacAuthDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, hmacAuthPluginName)
+}
+
+func createKongConsumerHmacAuthRequestFromResourceData(d *schema.ResourceData) *hmacAuthCredentialRequest {
+	return &hmacAuthCredentialRequest{
+		Username: readStringFromResource(d, "username"),
+		Secret:   readStringFromResource(d, "secret"),
+		Tags:     readTagsPtrFromResource(d),
+	}
+}
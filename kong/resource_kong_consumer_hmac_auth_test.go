@@ -0,0 +1,90 @@
+package kong
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerHmacAuth(t *testing.T) {
+
+	var credentialId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerHmacAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerHmacAuthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerHmacAuthExists("kong_consumer_hmac_auth.hmac_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_hmac_auth.hmac_auth", "username", "partner"),
+					resource.TestMatchResourceAttr("kong_consumer_hmac_auth.hmac_auth", "secret", regexp.MustCompile(".+")),
+					storeResourceId("kong_consumer_hmac_auth.hmac_auth", &credentialId),
+				),
+			},
+			{
+				Config: testUpdateConsumerHmacAuthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerHmacAuthExists("kong_consumer_hmac_auth.hmac_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_hmac_auth.hmac_auth", "username", "partner-renamed"),
+					resource.TestCheckResourceAttr("kong_consumer_hmac_auth.hmac_auth", "secret", "my-hmac-secret"),
+					checkResourceId("kong_consumer_hmac_auth.hmac_auth", &credentialId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerHmacAuthImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerHmacAuthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testUpdateConsumerHmacAuthConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_hmac_auth.hmac_auth",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerHmacAuthDestroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_hmac_auth", hmacAuthPluginName)
+}
+
+func testAccCheckKongConsumerHmacAuthExists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, hmacAuthPluginName)
+}
+
+const testCreateConsumerHmacAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "HmacAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_hmac_auth" "hmac_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "partner"
+}
+`
+const testUpdateConsumerHmacAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "HmacAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_hmac_auth" "hmac_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	username    = "partner-renamed"
+	secret      = "my-hmac-secret"
+}
+`
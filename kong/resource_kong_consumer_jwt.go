@@ -0,0 +1,201 @@
+package kong
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const jwtPluginName = "jwt"
+
+var jwtAlgorithms = []string{"HS256", "HS384", "HS512", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}
+
+// The public key is sent as null when it is not set so that removing it clears it in kong
+type jwtCredentialRequest struct {
+	Key          string    `json:"key,omitempty"`
+	Secret       string    `json:"secret,omitempty"`
+	Algorithm    string    `json:"algorithm,omitempty"`
+	RsaPublicKey *string   `json:"rsa_public_key"`
+	Tags         *[]string `json:"tags,omitempty"`
+}
+
+type jwtCredential struct {
+	Id           string   `json:"id"`
+	Key          string   `json:"key"`
+	Secret       string   `json:"secret"`
+	Algorithm    string   `json:"algorithm"`
+	RsaPublicKey string   `json:"rsa_public_key"`
+	Tags         []string `json:"tags"`
+}
+
+func resourceKongConsumerJwt() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKongConsumerJwtCreate,
+		Read:          resourceKongConsumerJwtRead,
+		Delete:        resourceKongConsumerJwtDelete,
+		Update:        resourceKongConsumerJwtUpdate,
+		CustomizeDiff: validateConsumerJwtPublicKey,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Kong generates the key and secret when they are not given
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"algorithm": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      "HS256",
+				ValidateFunc: validation.StringInSlice(jwtAlgorithms, false),
+			},
+			"rsa_public_key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+// validateConsumerJwtPublicKey checks the public key at plan time, kong would only reject it on apply.
+func validateConsumerJwtPublicKey(d *schema.ResourceDiff, meta interface{}) error {
+	algorithm := d.Get("algorithm").(string)
+	if strings.HasPrefix(algorithm, "HS") {
+		return nil
+	}
+
+	// The key may come from another resource that has not been created yet
+	if !d.NewValueKnown("rsa_public_key") {
+		return nil
+	}
+
+	publicKey := d.Get("rsa_public_key").(string)
+	if publicKey == "" {
+		return fmt.Errorf("rsa_public_key is required when algorithm is %s", algorithm)
+	}
+
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return fmt.Errorf("rsa_public_key is not a valid PEM encoded public key")
+	}
+
+	var key interface{}
+	var err error
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return fmt.Errorf("rsa_public_key is not a valid PEM encoded public key: %v", err)
+	}
+
+	switch key.(type) {
+	case *rsa.PublicKey:
+		if strings.HasPrefix(algorithm, "ES") {
+			return fmt.Errorf("rsa_public_key is an RSA key but algorithm %s needs an ECDSA key", algorithm)
+		}
+	case *ecdsa.PublicKey:
+		if !strings.HasPrefix(algorithm, "ES") {
+			return fmt.Errorf("rsa_public_key is an ECDSA key but algorithm %s needs an RSA key", algorithm)
+		}
+	default:
+		return fmt.Errorf("rsa_public_key must be an RSA or ECDSA public key")
+	}
+
+	return nil
+}
+
+func resourceKongConsumerJwtCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	jwtRequest := createKongConsumerJwtRequestFromResourceData(d)
+
+	jwt := &jwtCredential{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, jwtPluginName, ""), jwtRequest, jwt)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer jwt for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, jwt.Id))
+
+	return resourceKongConsumerJwtRead(d, meta)
+}
+
+func resourceKongConsumerJwtUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	jwtRequest := createKongConsumerJwtRequestFromResourceData(d)
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, jwtPluginName, credentialId), jwtRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer jwt: %s", err)
+	}
+
+	return resourceKongConsumerJwtRead(d, meta)
+}
+
+func resourceKongConsumerJwtRead(d *schema.ResourceData, meta interface{}) error {
+
+	jwt := &jwtCredential{}
+	found, err := readConsumerCredential(d, meta, jwtPluginName, jwt)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("key", jwt.Key)
+	d.Set("secret", jwt.Secret)
+	d.Set("algorithm", jwt.Algorithm)
+	d.Set("rsa_public_key", jwt.RsaPublicKey)
+	d.Set("tags", jwt.Tags)
+
+	return nil
+}
+
+func resourceKongConsumerJwtDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, jwtPluginName)
+}
+
+func createKongConsumerJwtRequestFromResourceData(d *schema.ResourceData) *jwtCredentialRequest {
+	return &jwtCredentialRequest{
+		Key:          readStringFromResource(d, "key"),
+		Secret:       readStringFromResource(d, "secret"),
+		Algorithm:    readStringFromResource(d, "algorithm"),
+		RsaPublicKey: readStringPtrFromResource(d, "rsa_public_key"),
+		Tags:         readTagsPtrFromResource(d),
+	}
+}
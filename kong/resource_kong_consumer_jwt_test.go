@@ -0,0 +1,152 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerJwt(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerJwtDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerJwtConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerJwtExists("kong_consumer_jwt.jwt"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "algorithm", "HS256"),
+					resource.TestMatchResourceAttr("kong_consumer_jwt.jwt", "key", regexp.MustCompile(".+")),
+					resource.TestMatchResourceAttr("kong_consumer_jwt.jwt", "secret", regexp.MustCompile(".+")),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testConsumerJwtWithPublicKeyConfig, "RS256", testJwtRsaPublicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerJwtExists("kong_consumer_jwt.jwt"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "key", "partner-issuer"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "algorithm", "RS256"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "rsa_public_key", testJwtRsaPublicKey+"\n"),
+				),
+			},
+			{
+				Config: testCreateConsumerJwtConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerJwtExists("kong_consumer_jwt.jwt"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "algorithm", "HS256"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "rsa_public_key", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerJwtWithPssAlgorithm(t *testing.T) {
+	// older versions of kong only know the HS, RS and ES256/384 algorithms
+	skipIfKongVersionBelow(t, "3.1.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerJwtDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testConsumerJwtWithPublicKeyConfig, "PS256", testJwtRsaPublicKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerJwtExists("kong_consumer_jwt.jwt"),
+					resource.TestCheckResourceAttr("kong_consumer_jwt.jwt", "algorithm", "PS256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerJwtImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerJwtDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testConsumerJwtWithPublicKeyConfig, "ES256", testJwtEcdsaPublicKey),
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_jwt.jwt",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerJwtRejectsInvalidPublicKey(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testConsumerJwtWithPublicKeyConfig, "RS256", "not a key"),
+				ExpectError: regexp.MustCompile("rsa_public_key is not a valid PEM encoded public key"),
+			},
+			{
+				Config:      fmt.Sprintf(testConsumerJwtWithPublicKeyConfig, "ES256", testJwtRsaPublicKey),
+				ExpectError: regexp.MustCompile("needs an ECDSA key"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerJwtDestroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_jwt", jwtPluginName)
+}
+
+func testAccCheckKongConsumerJwtExists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, jwtPluginName)
+}
+
+const testCreateConsumerJwtConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "JwtUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_jwt" "jwt" {
+	consumer_id = "${kong_consumer.consumer.id}"
+}
+`
+const testConsumerJwtWithPublicKeyConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "JwtUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_jwt" "jwt" {
+	consumer_id    = "${kong_consumer.consumer.id}"
+	key            = "partner-issuer"
+	algorithm      = "%s"
+	rsa_public_key = <<EOF
+%s
+EOF
+}
+`
+
+const (
+	testJwtRsaPublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAqL8EB1/Tx9PTDhB5oA1e
+40JC3zO2IzfrICTnXHL93DgTHNBsja/+4DW+uriM63g8PkL15VU337F3+XkQ/M5m
+pwbpqqtRYXAf+uoUNnITF7Q+xsFB9F14XLpZxF8/BdqaX2FYxhg80T/+YmByx4MF
+Kfe62mGxPGiIbtA9Saun5PrpDqIBE5E4tL0ELvuzO6USUXB7vY6f4RJeR47CTIqL
+MQgDAwU9puXTry943So4AmLtZAKyhvldEeXrGADF/3wttZCyKP22RmFKoiAxMFyJ
+bG3guulKAFtdDQFv0j6wzvGkr7nT65MWYxaF7+ofEICTTU9GeOQ/LskWCnIDBx2O
+pwIDAQAB
+-----END PUBLIC KEY-----`
+
+	testJwtEcdsaPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEr8SXJEVPc8EXqrc1PvdC5dTXCadu
+VHZQHKulvOtBPJUe8hO8NIZ3mlntxaZQXsE2Rio+FNg5K04kkld2BiCh1A==
+-----END PUBLIC KEY-----`
+)
@@ -0,0 +1,112 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const keyAuthPluginName = "key-auth"
+
+type keyAuthCredentialRequest struct {
+	Key  string    `json:"key,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+type keyAuthCredential struct {
+	Id   string   `json:"id"`
+	Key  string   `json:"key"`
+	Tags []string `json:"tags"`
+}
+
+func resourceKongConsumerKeyAuth() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerKeyAuthCreate,
+		Read:   resourceKongConsumerKeyAuthRead,
+		Delete: resourceKongConsumerKeyAuthDelete,
+		Update: resourceKongConsumerKeyAuthUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// Kong generates a key when none is given, it is read back into state so it can be handed to the consumer
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongConsumerKeyAuthCreate(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	keyAuthRequest := createKongConsumerKeyAuthRequestFromResourceData(d)
+
+	keyAuth := &keyAuthCredential{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, keyAuthPluginName, ""), keyAuthRequest, keyAuth)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer key auth for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, keyAuth.Id))
+
+	return resourceKongConsumerKeyAuthRead(d, meta)
+}
+
+func resourceKongConsumerKeyAuthUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	keyAuthRequest := createKongConsumerKeyAuthRequestFromResourceData(d)
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, keyAuthPluginName, credentialId), keyAuthRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer key auth: %s", err)
+	}
+
+	return resourceKongConsumerKeyAuthRead(d, meta)
+}
+
+func resourceKongConsumerKeyAuthRead(d *schema.ResourceData, meta interface{}) error {
+
+	keyAuth := &keyAuthCredential{}
+	found, err := readConsumerCredential(d, meta, keyAuthPluginName, keyAuth)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("key", keyAuth.Key)
+	d.Set("tags", keyAuth.Tags)
+
+	return nil
+}
+
+func resourceKongConsumerKeyAuthDelete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, keyAuthPluginName)
+}
+
+func createKongConsumerKeyAuthRequestFromResourceData(d *schema.ResourceData) *keyAuthCredentialRequest {
+	return &keyAuthCredentialRequest{
+		Key:  readStringFromResource(d, "key"),
+		Tags: readTagsPtrFromResource(d),
+	}
+}
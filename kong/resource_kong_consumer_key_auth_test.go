@@ -0,0 +1,215 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerKeyAuth(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerKeyAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerKeyAuthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerKeyAuthExists("kong_consumer_key_auth.key_auth"),
+					resource.TestMatchResourceAttr("kong_consumer_key_auth.key_auth", "key", regexp.MustCompile(".+")),
+				),
+			},
+			{
+				Config: testUpdateConsumerKeyAuthConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerKeyAuthExists("kong_consumer_key_auth.key_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_key_auth.key_auth", "key", "my-secret-key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerKeyAuthTagsUpdateInPlace(t *testing.T) {
+	skipIfKongVersionBelow(t, "1.1.0")
+
+	var credentialId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerKeyAuthDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testConsumerKeyAuthWithTagsConfig, `["team-a"]`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerKeyAuthExists("kong_consumer_key_auth.key_auth"),
+					resource.TestCheckResourceAttr("kong_consumer_key_auth.key_auth", "tags.#", "1"),
+					storeResourceId("kong_consumer_key_auth.key_auth", &credentialId),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testConsumerKeyAuthWithTagsConfig, `["team-a", "partner"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("kong_consumer_key_auth.key_auth", "tags.#", "2"),
+					checkResourceId("kong_consumer_key_auth.key_auth", &credentialId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerKeyAuthImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerKeyAuthDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testUpdateConsumerKeyAuthConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_key_auth.key_auth",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerKeyAuthDestroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_key_auth", keyAuthPluginName)
+}
+
+func testAccCheckKongConsumerKeyAuthExists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, keyAuthPluginName)
+}
+
+func testAccCheckKongConsumerCredentialDestroy(state *terraform.State, resourceType string, pluginName string) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	credentials := getResourcesByType(resourceType, state)
+
+	if len(credentials) != 1 {
+		return fmt.Errorf("expecting only 1 %s resource found %v", resourceType, len(credentials))
+	}
+
+	consumerId, credentialId, err := splitConsumerCredentialId(credentials[0].Primary.ID)
+
+	if err != nil {
+		return err
+	}
+
+	found, err := client.get(consumerCredentialPath(consumerId, pluginName, credentialId), &map[string]interface{}{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get %s credential by id: %v", pluginName, err)
+	}
+
+	if found {
+		return fmt.Errorf("%s credential %s still exists", pluginName, credentials[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongConsumerCredentialExists(resourceKey string, pluginName string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		consumerId, credentialId, err := splitConsumerCredentialId(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(consumerCredentialPath(consumerId, pluginName, credentialId), &map[string]interface{}{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("%s credential with id %v not found", pluginName, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// storeResourceId and checkResourceId are used together to make sure a resource was updated rather than recreated
+func storeResourceId(resourceKey string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		*id = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func checkResourceId(resourceKey string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID != *id {
+			return fmt.Errorf("%s was recreated, id changed from %s to %s", resourceKey, *id, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerKeyAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "KeyAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_key_auth" "key_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+}
+`
+const testUpdateConsumerKeyAuthConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "KeyAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_key_auth" "key_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	key         = "my-secret-key"
+}
+`
+const testConsumerKeyAuthWithTagsConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "KeyAuthUser"
+	custom_id = "123"
+}
+
+resource "kong_consumer_key_auth" "key_auth" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	tags        = %s
+}
+`
@@ -0,0 +1,176 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+const oauth2PluginName = "oauth2"
+
+type oauth2CredentialRequest struct {
+	Name         string    `json:"name,omitempty"`
+	ClientId     string    `json:"client_id,omitempty"`
+	ClientSecret string    `json:"client_secret,omitempty"`
+	RedirectUris []*string `json:"redirect_uris"`
+	HashSecret   *bool     `json:"hash_secret,omitempty"`
+	Tags         *[]string `json:"tags,omitempty"`
+}
+
+type oauth2Credential struct {
+	Id           string   `json:"id"`
+	Name         string   `json:"name"`
+	ClientId     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectUris []string `json:"redirect_uris"`
+	HashSecret   bool     `json:"hash_secret"`
+	Tags         []string `json:"tags"`
+}
+
+func resourceKongConsumerOAuth2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongConsumerOAuth2Create,
+		Read:   resourceKongConsumerOAuth2Read,
+		Delete: resourceKongConsumerOAuth2Delete,
+		Update: resourceKongConsumerOAuth2Update,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"consumer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			// Kong generates the client id and secret when they are not given
+			"client_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: false,
+			},
+			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Computed:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"redirect_uris": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Changing this would leave kong holding a secret in a different form to the one in state
+			"hash_secret": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongConsumerOAuth2Create(d *schema.ResourceData, meta interface{}) error {
+
+	consumerId := readStringFromResource(d, "consumer_id")
+	oauth2Request := createKongConsumerOAuth2RequestFromResourceData(d)
+	oauth2Request.ClientSecret = readStringFromResource(d, "client_secret")
+
+	oauth2 := &oauth2Credential{}
+	err := meta.(*config).adminApi.post(consumerCredentialPath(consumerId, oauth2PluginName, ""), oauth2Request, oauth2)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong consumer oauth2 for consumer: %s error: %v", consumerId, err)
+	}
+
+	d.SetId(buildConsumerCredentialId(consumerId, oauth2.Id))
+
+	// A hashed secret is never returned by kong again, the generated one has to be captured now
+	d.Set("client_secret", oauth2.ClientSecret)
+
+	return resourceKongConsumerOAuth2Read(d, meta)
+}
+
+func resourceKongConsumerOAuth2Update(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	consumerId, credentialId, err := splitConsumerCredentialId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	oauth2Request := createKongConsumerOAuth2RequestFromResourceData(d)
+
+	// Only send the secret when it changed, so a hashed secret is not hashed again
+	if d.HasChange("client_secret") {
+		oauth2Request.ClientSecret = readStringFromResource(d, "client_secret")
+	}
+
+	err = meta.(*config).adminApi.patch(consumerCredentialPath(consumerId, oauth2PluginName, credentialId), oauth2Request, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong consumer oauth2: %s", err)
+	}
+
+	return resourceKongConsumerOAuth2Read(d, meta)
+}
+
+func resourceKongConsumerOAuth2Read(d *schema.ResourceData, meta interface{}) error {
+
+	oauth2 := &oauth2Credential{}
+	found, err := readConsumerCredential(d, meta, oauth2PluginName, oauth2)
+
+	if err != nil || !found {
+		return err
+	}
+
+	d.Set("name", oauth2.Name)
+	d.Set("client_id", oauth2.ClientId)
+	d.Set("redirect_uris", oauth2.RedirectUris)
+	d.Set("hash_secret", oauth2.HashSecret)
+	d.Set("tags", oauth2.Tags)
+
+	// When hashed kong returns the hash rather than the secret, so the value in state is kept
+	if !oauth2.HashSecret {
+		d.Set("client_secret", oauth2.ClientSecret)
+	}
+
+	return nil
+}
+
+func resourceKongConsumerOAuth2Delete(d *schema.ResourceData, meta interface{}) error {
+	return deleteConsumerCredential(d, meta, oauth2PluginName)
+}
+
+func createKongConsumerOAuth2RequestFromResourceData(d *schema.ResourceData) *oauth2CredentialRequest {
+	oauth2Request := &oauth2CredentialRequest{
+		Name:         readStringFromResource(d, "name"),
+		ClientId:     readStringFromResource(d, "client_id"),
+		RedirectUris: readStringArrayPtrFromResource(d, "redirect_uris"),
+		Tags:         readTagsPtrFromResource(d),
+	}
+
+	// Redirect uris that were all removed need to be sent as an empty list to clear them
+	if oauth2Request.RedirectUris == nil {
+		oauth2Request.RedirectUris = []*string{}
+	}
+
+	// hash_secret only exists from kong 2.8 onwards so it is left out unless it is turned on
+	if d.Get("hash_secret").(bool) {
+		oauth2Request.HashSecret = gokong.Bool(true)
+	}
+
+	return oauth2Request
+}
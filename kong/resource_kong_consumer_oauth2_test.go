@@ -0,0 +1,148 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongConsumerOAuth2(t *testing.T) {
+
+	var credentialId string
+	var clientSecret string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerOAuth2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateConsumerOAuth2Config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerOAuth2Exists("kong_consumer_oauth2.oauth2"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "name", "partner-app"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "redirect_uris.#", "1"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "redirect_uris.0", "https://partner.example.com/callback"),
+					resource.TestMatchResourceAttr("kong_consumer_oauth2.oauth2", "client_id", regexp.MustCompile(".+")),
+					resource.TestMatchResourceAttr("kong_consumer_oauth2.oauth2", "client_secret", regexp.MustCompile(".+")),
+					storeResourceId("kong_consumer_oauth2.oauth2", &credentialId),
+					storeResourceAttr("kong_consumer_oauth2.oauth2", "client_secret", &clientSecret),
+				),
+			},
+			{
+				Config: testUpdateConsumerOAuth2Config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerOAuth2Exists("kong_consumer_oauth2.oauth2"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "name", "partner-app-renamed"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "redirect_uris.#", "2"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "redirect_uris.1", "https://partner.example.com/other-callback"),
+					checkResourceId("kong_consumer_oauth2.oauth2", &credentialId),
+					checkResourceAttr("kong_consumer_oauth2.oauth2", "client_secret", &clientSecret),
+				),
+			},
+			{
+				Config: testUpdateConsumerOAuth2RemoveRedirectUrisConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongConsumerOAuth2Exists("kong_consumer_oauth2.oauth2"),
+					resource.TestCheckResourceAttr("kong_consumer_oauth2.oauth2", "redirect_uris.#", "0"),
+					checkResourceId("kong_consumer_oauth2.oauth2", &credentialId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongConsumerOAuth2Import(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongConsumerOAuth2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testUpdateConsumerOAuth2Config,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_consumer_oauth2.oauth2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongConsumerOAuth2Destroy(state *terraform.State) error {
+	return testAccCheckKongConsumerCredentialDestroy(state, "kong_consumer_oauth2", oauth2PluginName)
+}
+
+func testAccCheckKongConsumerOAuth2Exists(resourceKey string) resource.TestCheckFunc {
+	return testAccCheckKongConsumerCredentialExists(resourceKey, oauth2PluginName)
+}
+
+func storeResourceAttr(resourceKey string, attr string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		*value = rs.Primary.Attributes[attr]
+
+		return nil
+	}
+}
+
+func checkResourceAttr(resourceKey string, attr string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.Attributes[attr] != *value {
+			return fmt.Errorf("%s %s changed from %s to %s", resourceKey, attr, *value, rs.Primary.Attributes[attr])
+		}
+
+		return nil
+	}
+}
+
+const testCreateConsumerOAuth2Config = `
+resource "kong_consumer" "consumer" {
+	username  = "OAuth2User"
+	custom_id = "123"
+}
+
+resource "kong_consumer_oauth2" "oauth2" {
+	consumer_id   = "${kong_consumer.consumer.id}"
+	name          = "partner-app"
+	redirect_uris = ["https://partner.example.com/callback"]
+}
+`
+const testUpdateConsumerOAuth2Config = `
+resource "kong_consumer" "consumer" {
+	username  = "OAuth2User"
+	custom_id = "123"
+}
+
+resource "kong_consumer_oauth2" "oauth2" {
+	consumer_id   = "${kong_consumer.consumer.id}"
+	name          = "partner-app-renamed"
+	redirect_uris = ["https://partner.example.com/callback", "https://partner.example.com/other-callback"]
+}
+`
+const testUpdateConsumerOAuth2RemoveRedirectUrisConfig = `
+resource "kong_consumer" "consumer" {
+	username  = "OAuth2User"
+	custom_id = "123"
+}
+
+resource "kong_consumer_oauth2" "oauth2" {
+	consumer_id = "${kong_consumer.consumer.id}"
+	name        = "partner-app-renamed"
+}
+`
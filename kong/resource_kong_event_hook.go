@@ -0,0 +1,207 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const eventHooksPath = "/event-hooks/"
+
+var eventHookHandlers = []string{"webhook", "webhook-custom", "log", "lambda"}
+
+type eventHookRequest struct {
+	Source  string                 `json:"source,omitempty"`
+	Event   *string                `json:"event"`
+	Handler string                 `json:"handler,omitempty"`
+	Config  map[string]interface{} `json:"config"`
+}
+
+type eventHook struct {
+	Id      string                 `json:"id"`
+	Source  string                 `json:"source"`
+	Event   *string                `json:"event"`
+	Handler string                 `json:"handler"`
+	Config  map[string]interface{} `json:"config"`
+}
+
+// Kong lists the events of every source it can send, keyed by source and then event
+type eventHookSources struct {
+	Data map[string]map[string]json.RawMessage `json:"data"`
+}
+
+func resourceKongEventHook() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKongEventHookCreate,
+		Read:          resourceKongEventHookRead,
+		Delete:        resourceKongEventHookDelete,
+		Update:        resourceKongEventHookUpdate,
+		CustomizeDiff: validateEventHookSource,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"event": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    false,
+				Description: "The event of the source to hook into, every event of the source when not set",
+			},
+			"handler": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validation.StringInSlice(eventHookHandlers, false),
+			},
+			"config_json": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				StateFunc:    normalizeDataJSON,
+				ValidateFunc: validateDataJSON,
+				Description:  "handler configuration in JSON format, configuration must be a valid JSON object.",
+			},
+		},
+	}
+}
+
+func resourceKongEventHookCreate(d *schema.ResourceData, meta interface{}) error {
+
+	eventHookRequest, err := createKongEventHookRequestFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	eventHook := &eventHook{}
+	err = meta.(*config).adminApi.post(eventHooksPath, eventHookRequest, eventHook)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong event hook: %s error: %v", eventHookRequest.Source, err)
+	}
+
+	d.SetId(eventHook.Id)
+
+	return resourceKongEventHookRead(d, meta)
+}
+
+func resourceKongEventHookUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	eventHookRequest, err := createKongEventHookRequestFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*config).adminApi.patch(eventHooksPath+d.Id(), eventHookRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong event hook: %s", err)
+	}
+
+	return resourceKongEventHookRead(d, meta)
+}
+
+func resourceKongEventHookRead(d *schema.ResourceData, meta interface{}) error {
+
+	eventHook := &eventHook{}
+	found, err := meta.(*config).adminApi.get(eventHooksPath+d.Id(), eventHook)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong event hook: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("source", eventHook.Source)
+	d.Set("event", eventHook.Event)
+	d.Set("handler", eventHook.Handler)
+	d.Set("config_json", configuredKeysJsonToString(eventHook.Config, readStringFromResource(d, "config_json")))
+
+	return nil
+}
+
+func resourceKongEventHookDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(eventHooksPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong event hook: %v", err)
+	}
+
+	return nil
+}
+
+// validateEventHookSource checks the source and event against the ones kong can send, it is skipped when kong can
+// not be reached so that plans still work without access to the admin api.
+func validateEventHookSource(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source") || !d.NewValueKnown("event") {
+		return nil
+	}
+
+	sources := &eventHookSources{}
+	found, err := meta.(*config).adminApi.get(eventHooksPath+"sources", sources)
+
+	if err != nil || !found {
+		log.Printf("[WARN] could not read kong event hook sources, not validating source and event: %v", err)
+		return nil
+	}
+
+	source := d.Get("source").(string)
+	events, ok := sources.Data[source]
+	if !ok {
+		var names []string
+		for name := range sources.Data {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("source %s is not a kong event hook source, expected one of %s", source, strings.Join(names, ", "))
+	}
+
+	event := d.Get("event").(string)
+	if _, ok := events[event]; event != "" && !ok {
+		var names []string
+		for name := range events {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("event %s is not an event of kong event hook source %s, expected one of %s", event, source, strings.Join(names, ", "))
+	}
+
+	return nil
+}
+
+func createKongEventHookRequestFromResourceData(d *schema.ResourceData) (*eventHookRequest, error) {
+
+	eventHookRequest := &eventHookRequest{
+		Source:  readStringFromResource(d, "source"),
+		Handler: readStringFromResource(d, "handler"),
+		Config:  map[string]interface{}{},
+	}
+
+	// A null event hooks into every event of the source
+	if event := readStringFromResource(d, "event"); event != "" {
+		eventHookRequest.Event = &event
+	}
+
+	if data, ok := d.GetOk("config_json"); ok {
+		if err := json.Unmarshal([]byte(data.(string)), &eventHookRequest.Config); err != nil {
+			return eventHookRequest, fmt.Errorf("failed to unmarshal config_json, err: %v", err)
+		}
+	}
+
+	return eventHookRequest, nil
+}
@@ -0,0 +1,151 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongEventHook(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongEventHookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateEventHookConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEventHookExists("kong_event_hook.consumer_created"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "source", "crud"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "event", "consumers"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "handler", "webhook"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "config_json", `{"url":"http://example.com/consumers"}`),
+				),
+			},
+			{
+				Config: testUpdateEventHookConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongEventHookExists("kong_event_hook.consumer_created"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "event", "services"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "handler", "log"),
+					resource.TestCheckResourceAttr("kong_event_hook.consumer_created", "config_json", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongEventHookImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongEventHookDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateEventHookConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:            "kong_event_hook.consumer_created",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config_json"},
+			},
+		},
+	})
+}
+
+func TestAccKongEventHookRejectsUnknownSource(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testUnknownSourceEventHookConfig,
+				ExpectError: regexp.MustCompile("source not-a-source is not a kong event hook source"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongEventHookDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	eventHooks := getResourcesByType("kong_event_hook", state)
+
+	if len(eventHooks) != 1 {
+		return fmt.Errorf("expecting only 1 event hook resource found %v", len(eventHooks))
+	}
+
+	found, err := client.get(eventHooksPath+eventHooks[0].Primary.ID, &eventHook{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get event hook by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("event hook %s still exists", eventHooks[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongEventHookExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(eventHooksPath+rs.Primary.ID, &eventHook{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("event hook with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateEventHookConfig = `
+resource "kong_event_hook" "consumer_created" {
+	source      = "crud"
+	event       = "consumers"
+	handler     = "webhook"
+	config_json = <<EOT
+	{
+		"url": "http://example.com/consumers"
+	}
+EOT
+}
+`
+const testUpdateEventHookConfig = `
+resource "kong_event_hook" "consumer_created" {
+	source  = "crud"
+	event   = "services"
+	handler = "log"
+}
+`
+const testUnknownSourceEventHookConfig = `
+resource "kong_event_hook" "consumer_created" {
+	source  = "not-a-source"
+	handler = "log"
+}
+`
@@ -0,0 +1,211 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+const keysPath = "/keys/"
+
+type keyPem struct {
+	PublicKey  string `json:"public_key,omitempty"`
+	PrivateKey string `json:"private_key,omitempty"`
+}
+
+// The name and the representation not in use are sent as null so that removing them clears them in kong
+type keyRequest struct {
+	Name  *string    `json:"name"`
+	Kid   string     `json:"kid,omitempty"`
+	SetId *gokong.Id `json:"set"`
+	Jwk   *string    `json:"jwk"`
+	Pem   *keyPem    `json:"pem"`
+	Tags  *[]string  `json:"tags,omitempty"`
+}
+
+type key struct {
+	Id    string     `json:"id"`
+	Name  string     `json:"name"`
+	Kid   string     `json:"kid"`
+	SetId *gokong.Id `json:"set"`
+	Jwk   string     `json:"jwk"`
+	Pem   *keyPem    `json:"pem"`
+	Tags  []string   `json:"tags"`
+}
+
+func resourceKongKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongKeyCreate,
+		Read:   resourceKongKeyRead,
+		Delete: resourceKongKeyDelete,
+		Update: resourceKongKeyUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"kid": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"set_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			// A JWK may hold the private key so it is treated as sensitive as a whole
+			"jwk": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Sensitive:    true,
+				StateFunc:    normalizeDataJSON,
+				ValidateFunc: validateDataJSON,
+				ExactlyOneOf: []string{"jwk", "pem"},
+			},
+			"pem": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: false,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"private_key": &schema.Schema{
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+					},
+				},
+				ExactlyOneOf: []string{"jwk", "pem"},
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongKeyCreate(d *schema.ResourceData, meta interface{}) error {
+
+	keyRequest := createKongKeyRequestFromResourceData(d)
+
+	key := &key{}
+	err := meta.(*config).adminApi.post(keysPath, keyRequest, key)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong key: %s error: %v", keyRequest.Kid, err)
+	}
+
+	d.SetId(key.Id)
+
+	return resourceKongKeyRead(d, meta)
+}
+
+func resourceKongKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	keyRequest := createKongKeyRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(keysPath+d.Id(), keyRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong key: %s", err)
+	}
+
+	return resourceKongKeyRead(d, meta)
+}
+
+func resourceKongKeyRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The id is the key name when importing, kong accepts both
+	key := &key{}
+	found, err := meta.(*config).adminApi.get(keysPath+d.Id(), key)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong key: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(key.Id)
+	d.Set("name", key.Name)
+	d.Set("kid", key.Kid)
+	d.Set("set_id", gokong.IdToString(key.SetId))
+	d.Set("tags", key.Tags)
+
+	if key.Pem != nil && key.Pem.PublicKey != "" {
+		d.Set("pem", flattenKeyPem(key.Pem, d))
+		d.Set("jwk", "")
+	} else if key.Jwk != "" {
+		d.Set("jwk", normalizeDataJSON(key.Jwk))
+		d.Set("pem", nil)
+	}
+
+	return nil
+}
+
+func resourceKongKeyDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(keysPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong key: %v", err)
+	}
+
+	return nil
+}
+
+func createKongKeyRequestFromResourceData(d *schema.ResourceData) *keyRequest {
+
+	keyRequest := &keyRequest{
+		Kid:   readStringFromResource(d, "kid"),
+		SetId: readIdPtrFromResource(d, "set_id"),
+		Tags:  readTagsPtrFromResource(d),
+	}
+
+	if name := readStringFromResource(d, "name"); name != "" {
+		keyRequest.Name = &name
+	}
+
+	if jwk := readStringFromResource(d, "jwk"); jwk != "" {
+		keyRequest.Jwk = &jwk
+	}
+
+	if pems := readArrayFromResource(d, "pem"); len(pems) > 0 && pems[0] != nil {
+		pem := pems[0].(map[string]interface{})
+		keyRequest.Pem = &keyPem{
+			PublicKey:  pem["public_key"].(string),
+			PrivateKey: pem["private_key"].(string),
+		}
+	}
+
+	return keyRequest
+}
+
+// flattenKeyPem keeps the private key from state when kong does not return it
+func flattenKeyPem(pem *keyPem, d *schema.ResourceData) []interface{} {
+	privateKey := pem.PrivateKey
+	if privateKey == "" {
+		privateKey = d.Get("pem.0.private_key").(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"public_key":  pem.PublicKey,
+			"private_key": privateKey,
+		},
+	}
+}
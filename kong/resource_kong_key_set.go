@@ -0,0 +1,110 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const keySetsPath = "/key-sets/"
+
+type keySetRequest struct {
+	Name string    `json:"name,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+type keySet struct {
+	Id   string   `json:"id"`
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+func resourceKongKeySet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongKeySetCreate,
+		Read:   resourceKongKeySetRead,
+		Delete: resourceKongKeySetDelete,
+		Update: resourceKongKeySetUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongKeySetCreate(d *schema.ResourceData, meta interface{}) error {
+
+	keySetRequest := createKongKeySetRequestFromResourceData(d)
+
+	keySet := &keySet{}
+	err := meta.(*config).adminApi.post(keySetsPath, keySetRequest, keySet)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong key set: %v error: %v", keySetRequest, err)
+	}
+
+	d.SetId(keySet.Id)
+
+	return resourceKongKeySetRead(d, meta)
+}
+
+func resourceKongKeySetUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	keySetRequest := createKongKeySetRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(keySetsPath+d.Id(), keySetRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong key set: %s", err)
+	}
+
+	return resourceKongKeySetRead(d, meta)
+}
+
+func resourceKongKeySetRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The id is the key set name when importing, kong accepts both
+	keySet := &keySet{}
+	found, err := meta.(*config).adminApi.get(keySetsPath+d.Id(), keySet)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong key set: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+	} else {
+		d.SetId(keySet.Id)
+		d.Set("name", keySet.Name)
+		d.Set("tags", keySet.Tags)
+	}
+
+	return nil
+}
+
+func resourceKongKeySetDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(keySetsPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong key set: %v", err)
+	}
+
+	return nil
+}
+
+func createKongKeySetRequestFromResourceData(d *schema.ResourceData) *keySetRequest {
+	return &keySetRequest{
+		Name: readStringFromResource(d, "name"),
+		Tags: readTagsPtrFromResource(d),
+	}
+}
@@ -0,0 +1,118 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongKeySet(t *testing.T) {
+	skipIfKongVersionBelow(t, "3.1.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongKeySetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateKeySetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongKeySetExists("kong_key_set.set"),
+					resource.TestCheckResourceAttr("kong_key_set.set", "name", "oidc"),
+				),
+			},
+			{
+				Config: testUpdateKeySetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongKeySetExists("kong_key_set.set"),
+					resource.TestCheckResourceAttr("kong_key_set.set", "name", "oidc-rotated"),
+					resource.TestCheckResourceAttr("kong_key_set.set", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongKeySetImportByName(t *testing.T) {
+	skipIfKongVersionBelow(t, "3.1.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongKeySetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateKeySetConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_key_set.set",
+				ImportState:       true,
+				ImportStateId:     "oidc",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongKeySetDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	keySets := getResourcesByType("kong_key_set", state)
+
+	if len(keySets) != 1 {
+		return fmt.Errorf("expecting only 1 key set resource found %v", len(keySets))
+	}
+
+	found, err := client.get(keySetsPath+keySets[0].Primary.ID, &keySet{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get key set by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("key set %s still exists", keySets[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongKeySetExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(keySetsPath+rs.Primary.ID, &keySet{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("key set with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateKeySetConfig = `
+resource "kong_key_set" "set" {
+	name = "oidc"
+}
+`
+const testUpdateKeySetConfig = `
+resource "kong_key_set" "set" {
+	name = "oidc-rotated"
+	tags = ["rotated"]
+}
+`
@@ -0,0 +1,239 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+const licensesPath = "/licenses/"
+
+const licenseDateFormat = "2006-01-02"
+
+const licenseExpirationWarningDays = 30
+
+type licenseRequest struct {
+	Payload string `json:"payload"`
+}
+
+type license struct {
+	Id      string `json:"id"`
+	Payload string `json:"payload"`
+}
+
+type licenseDocument struct {
+	License struct {
+		Payload struct {
+			Customer              string `json:"customer"`
+			ProductSubscription   string `json:"product_subscription"`
+			LicenseExpirationDate string `json:"license_expiration_date"`
+		} `json:"payload"`
+	} `json:"license"`
+}
+
+func resourceKongLicense() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKongLicenseCreate,
+		Read:          resourceKongLicenseRead,
+		Delete:        resourceKongLicenseDelete,
+		Update:        resourceKongLicenseUpdate,
+		CustomizeDiff: customizeLicenseDiff,
+		Importer: &schema.ResourceImporter{
+			State: importLicense,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"payload": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				Sensitive:    true,
+				StateFunc:    normalizeDataJSON,
+				ValidateFunc: validateLicensePayload,
+			},
+			"expiration_warning_days": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      licenseExpirationWarningDays,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"customer": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKongLicenseCreate(d *schema.ResourceData, meta interface{}) error {
+
+	license := &license{}
+	err := meta.(*config).adminApi.post(licensesPath, &licenseRequest{Payload: readStringFromResource(d, "payload")}, license)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong license error: %v", err)
+	}
+
+	d.SetId(license.Id)
+
+	return resourceKongLicenseRead(d, meta)
+}
+
+func resourceKongLicenseUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	// only the payload is kept in kong, the warning days are just for the plan
+	if d.HasChange("payload") {
+		err := meta.(*config).adminApi.patch(licensesPath+d.Id(), &licenseRequest{Payload: readStringFromResource(d, "payload")}, nil)
+
+		if err != nil {
+			return fmt.Errorf("error updating kong license: %s", err)
+		}
+	}
+
+	return resourceKongLicenseRead(d, meta)
+}
+
+func resourceKongLicenseRead(d *schema.ResourceData, meta interface{}) error {
+
+	license := &license{}
+	found, err := meta.(*config).adminApi.get(licensesPath+d.Id(), license)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong license: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	document, err := parseLicensePayload(license.Payload)
+
+	if err != nil {
+		return fmt.Errorf("could not read kong license %s: %v", d.Id(), err)
+	}
+
+	d.Set("payload", normalizeDataJSON(license.Payload))
+	for key, value := range licenseFields(document) {
+		d.Set(key, value)
+	}
+
+	return nil
+}
+
+// importLicense sets the warning days to the default as kong does not know about them
+func importLicense(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("expiration_warning_days", licenseExpirationWarningDays)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceKongLicenseDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(licensesPath + d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong license: %v", err)
+	}
+
+	return nil
+}
+
+// customizeLicenseDiff warns when the license is about to expire and fills in the fields read from the payload on
+// the plan so they are known before the apply
+func customizeLicenseDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("payload") {
+		return nil
+	}
+
+	document, err := parseLicensePayload(d.Get("payload").(string))
+
+	if err != nil {
+		return fmt.Errorf("payload: %v", err)
+	}
+
+	if warning := licenseExpirationWarning(document, d.Get("expiration_warning_days").(int)); warning != "" {
+		log.Printf("[WARN] %s", warning)
+	}
+
+	if !d.HasChange("payload") {
+		return nil
+	}
+
+	for key, value := range licenseFields(document) {
+		if err := d.SetNew(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateLicensePayload(value interface{}, k string) ([]string, []error) {
+	if _, err := parseLicensePayload(value.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+
+	return nil, nil
+}
+
+// licenseExpirationWarning is empty unless the license has expired or expires within warningDays
+func licenseExpirationWarning(document *licenseDocument, warningDays int) string {
+	expiration, _ := time.Parse(licenseDateFormat, document.License.Payload.LicenseExpirationDate)
+	daysLeft := int(math.Ceil(time.Until(expiration).Hours() / 24))
+
+	if daysLeft < 0 {
+		return fmt.Sprintf("the kong license for %s expired on %s", document.License.Payload.Customer,
+			document.License.Payload.LicenseExpirationDate)
+	}
+
+	if daysLeft <= warningDays {
+		return fmt.Sprintf("the kong license for %s expires on %s, in %d days", document.License.Payload.Customer,
+			document.License.Payload.LicenseExpirationDate, daysLeft)
+	}
+
+	return ""
+}
+
+func parseLicensePayload(payload string) (*licenseDocument, error) {
+	document := &licenseDocument{}
+
+	if err := json.Unmarshal([]byte(payload), document); err != nil {
+		return nil, fmt.Errorf("license payload is not valid JSON: %v", err)
+	}
+
+	expiration := document.License.Payload.LicenseExpirationDate
+	if expiration == "" {
+		return nil, fmt.Errorf("license payload has no license.payload.license_expiration_date")
+	}
+
+	if _, err := time.Parse(licenseDateFormat, expiration); err != nil {
+		return nil, fmt.Errorf("license expiration date %s is not a date of the form YYYY-MM-DD", expiration)
+	}
+
+	return document, nil
+}
+
+// licenseFields are the computed fields, taken from the payload the same way on the plan and on the read
+func licenseFields(document *licenseDocument) map[string]string {
+	return map[string]string{
+		"expiration": document.License.Payload.LicenseExpirationDate,
+		"customer":   document.License.Payload.Customer,
+		"product":    document.License.Payload.ProductSubscription,
+	}
+}
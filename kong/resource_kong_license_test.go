@@ -0,0 +1,151 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// A license can not be checked in, the tests use the one kong enterprise itself reads from KONG_LICENSE_DATA
+func testLicensePayload(t *testing.T) string {
+	skipUnlessKongEnterprise(t)
+
+	payload := GetEnvVarOrDefault("KONG_LICENSE_DATA", "")
+	if payload == "" {
+		t.Skip("set KONG_LICENSE_DATA to a kong enterprise license to test licenses")
+	}
+
+	return strings.TrimSpace(payload)
+}
+
+func TestAccKongLicense(t *testing.T) {
+	payload := testLicensePayload(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongLicenseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(testCreateLicenseConfig, payload),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongLicenseExists("kong_license.license"),
+					resource.TestMatchResourceAttr("kong_license.license", "expiration", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)),
+					resource.TestMatchResourceAttr("kong_license.license", "customer", regexp.MustCompile(".+")),
+					resource.TestMatchResourceAttr("kong_license.license", "product", regexp.MustCompile(".+")),
+					resource.TestCheckResourceAttr("kong_license.license", "expiration_warning_days", "30"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(testUpdateLicenseWarningDaysConfig, payload),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongLicenseExists("kong_license.license"),
+					resource.TestCheckResourceAttr("kong_license.license", "expiration_warning_days", "90"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongLicenseImport(t *testing.T) {
+	payload := testLicensePayload(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongLicenseDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testCreateLicenseConfig, payload),
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_license.license",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKongLicenseRejectsInvalidPayload(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testCreateLicenseConfig, `{"license":{"payload":{"customer":"Acme"}}}`),
+				ExpectError: regexp.MustCompile("license payload has no license.payload.license_expiration_date"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongLicenseDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	licenses := getResourcesByType("kong_license", state)
+
+	if len(licenses) != 1 {
+		return fmt.Errorf("expecting only 1 license resource found %v", len(licenses))
+	}
+
+	found, err := client.get(licensesPath+licenses[0].Primary.ID, &license{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get license by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("license %s still exists", licenses[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongLicenseExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(licensesPath+rs.Primary.ID, &license{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("license with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateLicenseConfig = `
+resource "kong_license" "license" {
+	payload = <<EOF
+%s
+EOF
+}
+`
+
+const testUpdateLicenseWarningDaysConfig = `
+resource "kong_license" "license" {
+	payload                 = <<EOF
+%s
+EOF
+	expiration_warning_days = 90
+}
+`
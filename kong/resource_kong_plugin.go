@@ -11,6 +11,24 @@ import (
 	"github.com/kevholditch/gokong"
 )
 
+// kongPluginRequest and kongPlugin extend the gokong types with the consumer group scope added in kong 3.4
+type kongPluginRequest struct {
+	gokong.PluginRequest
+	ConsumerGroupId *gokong.Id `json:"consumer_group,omitempty"`
+}
+
+// kongPluginClearConsumerGroupRequest removes the consumer group scope, which leaving it out of the request does not.
+// It is only sent then as kong before 3.4 rejects the field.
+type kongPluginClearConsumerGroupRequest struct {
+	gokong.PluginRequest
+	ConsumerGroupId *gokong.Id `json:"consumer_group"`
+}
+
+type kongPlugin struct {
+	gokong.Plugin
+	ConsumerGroupId *gokong.Id `json:"consumer_group,omitempty"`
+}
+
 func resourceKongPlugin() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKongPluginCreate,
@@ -43,6 +61,11 @@ func resourceKongPlugin() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"consumer_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
 			"enabled": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -53,7 +76,7 @@ func resourceKongPlugin() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				StateFunc:    normalizeDataJSON,
-				ValidateFunc: validateDataJSON,
+				ValidateFunc: validatePluginConfigJSON,
 				Description:  "plugin configuration in JSON format, configuration must be a valid JSON object.",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return new == ""
@@ -75,22 +98,27 @@ func resourceKongPlugin() *schema.Resource {
 
 func resourceKongPluginCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*config)
-	pluginClient := config.adminClient.Plugins()
 
 	pluginRequest, err := createKongPluginRequestFromResourceData(d)
 	if err != nil {
 		return err
 	}
 
+	if err := checkVaultReferencesDeclared(meta, pluginRequest.Config); err != nil {
+		return err
+	}
+
 	var pluginID string
 	if err := resource.Retry(config.retryTimeout, func() *resource.RetryError {
 		log.Printf("creating plugin %s", pluginRequest.Name)
 
-		plugin, err := pluginClient.Create(pluginRequest)
+		plugin := &kongPlugin{}
+		err := config.adminApi.post(gokong.PluginsPath, pluginRequest, plugin)
 		if err != nil {
 			if config.upsertResources && strings.Contains(err.Error(), "unique constraint violation") {
 				dbPlugin, err := findPlugin(
-					pluginClient, pluginRequest.Name, pluginRequest.ConsumerId, pluginRequest.RouteId, pluginRequest.ServiceId,
+					config.adminApi, pluginRequest.Name, pluginRequest.ConsumerId, pluginRequest.RouteId, pluginRequest.ServiceId,
+					pluginRequest.ConsumerGroupId,
 				)
 				if err != nil {
 					return &resource.RetryError{
@@ -126,7 +154,16 @@ func resourceKongPluginUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	_, err = meta.(*config).adminClient.Plugins().UpdateById(d.Id(), pluginRequest)
+	if err := checkVaultReferencesDeclared(meta, pluginRequest.Config); err != nil {
+		return err
+	}
+
+	var request interface{} = pluginRequest
+	if d.HasChange("consumer_group_id") && pluginRequest.ConsumerGroupId == nil {
+		request = &kongPluginClearConsumerGroupRequest{PluginRequest: pluginRequest.PluginRequest}
+	}
+
+	err = meta.(*config).adminApi.patch(gokong.PluginsPath+d.Id(), request, nil)
 
 	if err != nil {
 		return fmt.Errorf("error updating kong plugin: %s", err)
@@ -137,19 +174,21 @@ func resourceKongPluginUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceKongPluginRead(d *schema.ResourceData, meta interface{}) error {
 
-	plugin, err := meta.(*config).adminClient.Plugins().GetById(d.Id())
+	plugin := &kongPlugin{}
+	found, err := meta.(*config).adminApi.get(gokong.PluginsPath+d.Id(), plugin)
 
 	if err != nil {
 		return fmt.Errorf("could not find kong plugin: %v", err)
 	}
 
-	if plugin == nil {
+	if !found {
 		d.SetId("")
 	} else {
 		d.Set("name", plugin.Name)
 		d.Set("service_id", plugin.ServiceId)
 		d.Set("route_id", plugin.RouteId)
 		d.Set("consumer_id", plugin.ConsumerId)
+		d.Set("consumer_group_id", plugin.ConsumerGroupId)
 		d.Set("enabled", plugin.Enabled)
 
 		// We sync this property from upstream as a method to allow you to import a resource with the config tracked in
@@ -175,7 +214,7 @@ func resourceKongPluginRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceKongPluginDelete(d *schema.ResourceData, meta interface{}) error {
 
-	err := meta.(*config).adminClient.Plugins().DeleteById(d.Id())
+	err := meta.(*config).adminApi.delete(gokong.PluginsPath + d.Id())
 
 	if err != nil {
 		return fmt.Errorf("could not delete kong plugin: %v", err)
@@ -184,14 +223,33 @@ func resourceKongPluginDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func createKongPluginRequestFromResourceData(d *schema.ResourceData) (*gokong.PluginRequest, error) {
+// validatePluginConfigJSON also checks that the vault references in the config are well formed
+func validatePluginConfigJSON(configI interface{}, k string) ([]string, []error) {
+	warnings, errors := validateDataJSON(configI, k)
+	if len(errors) > 0 {
+		return warnings, errors
+	}
 
-	pluginRequest := &gokong.PluginRequest{}
+	dataMap := map[string]interface{}{}
+	// validateDataJSON has made sure it is valid JSON
+	json.Unmarshal([]byte(configI.(string)), &dataMap)
+
+	if _, err := vaultReferences(dataMap); err != nil {
+		return warnings, []error{fmt.Errorf("%s: %v", k, err)}
+	}
+
+	return warnings, nil
+}
+
+func createKongPluginRequestFromResourceData(d *schema.ResourceData) (*kongPluginRequest, error) {
+
+	pluginRequest := &kongPluginRequest{}
 
 	pluginRequest.Name = readStringFromResource(d, "name")
 	pluginRequest.ConsumerId = readIdPtrFromResource(d, "consumer_id")
 	pluginRequest.ServiceId = readIdPtrFromResource(d, "service_id")
 	pluginRequest.RouteId = readIdPtrFromResource(d, "route_id")
+	pluginRequest.ConsumerGroupId = readIdPtrFromResource(d, "consumer_group_id")
 	pluginRequest.Enabled = readBoolPtrFromResource(d, "enabled")
 
 	if data, ok := d.GetOk("config_json"); ok {
@@ -223,19 +281,25 @@ func pluginConfigJsonToString(data map[string]interface{}) string {
 }
 
 func findPlugin(
-	pluginClient *gokong.PluginClient, name string, consumerId *gokong.Id, routeId *gokong.Id, serviceId *gokong.Id,
-) (*gokong.Plugin, error) {
+	client *adminApiClient, name string, consumerId *gokong.Id, routeId *gokong.Id, serviceId *gokong.Id,
+	consumerGroupId *gokong.Id,
+) (*kongPlugin, error) {
 	// Size is just how many plugins per request (1000 is the max)
-	// but List will fetch all the pages so all the plugins
-	dbPlugins, err := pluginClient.List(&gokong.PluginQueryString{Size: 1000})
+	// but list will fetch all the pages so all the plugins
+	dbPlugins, err := client.list(gokong.PluginsPath + "?size=1000")
 	if err != nil {
 		return nil, fmt.Errorf("could not read existing plugins: %w", err)
 	}
-	for _, p := range dbPlugins {
+	for _, raw := range dbPlugins {
+		p := &kongPlugin{}
+		if err := json.Unmarshal(raw, p); err != nil {
+			return nil, fmt.Errorf("could not read existing plugins: %w", err)
+		}
 		if p.Name == name &&
 			gokong.IdToString(p.ConsumerId) == gokong.IdToString(consumerId) &&
 			gokong.IdToString(p.RouteId) == gokong.IdToString(routeId) &&
-			gokong.IdToString(p.ServiceId) == gokong.IdToString(serviceId) {
+			gokong.IdToString(p.ServiceId) == gokong.IdToString(serviceId) &&
+			gokong.IdToString(p.ConsumerGroupId) == gokong.IdToString(consumerGroupId) {
 			return p, nil
 		}
 	}
@@ -143,6 +143,34 @@ func TestAccKongPluginForASpecificRoute(t *testing.T) {
 	})
 }
 
+func TestAccKongPluginForASpecificConsumerGroup(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+	skipIfKongVersionBelow(t, "3.4.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreatePluginForASpecificConsumerGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.rate_limit"),
+					testAccCheckKongConsumerGroupExists("kong_consumer_group.group"),
+					testAccCheckForChildIdCorrect("kong_consumer_group.group", "kong_plugin.rate_limit", "consumer_group_id"),
+					resource.TestCheckResourceAttr("kong_plugin.rate_limit", "name", "rate-limiting"),
+				),
+			},
+			{
+				Config: testUpdatePluginRemoveConsumerGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.rate_limit"),
+					resource.TestCheckResourceAttr("kong_plugin.rate_limit", "consumer_group_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKongPluginImportConfigJson(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -512,6 +540,39 @@ EOT
 }
 `
 
+const testCreatePluginForASpecificConsumerGroupConfig = `
+resource "kong_consumer_group" "group" {
+	name = "gold"
+}
+
+resource "kong_plugin" "rate_limit" {
+	name              = "rate-limiting"
+	consumer_group_id = "${kong_consumer_group.group.id}"
+	config_json       = <<EOT
+	{
+		"second": 5,
+		"hour" : 1000
+	}
+EOT
+}
+`
+
+const testUpdatePluginRemoveConsumerGroupConfig = `
+resource "kong_consumer_group" "group" {
+	name = "gold"
+}
+
+resource "kong_plugin" "rate_limit" {
+	name        = "rate-limiting"
+	config_json = <<EOT
+	{
+		"second": 5,
+		"hour" : 1000
+	}
+EOT
+}
+`
+
 const testImportPluginForASpecificApiConfig = `
 resource "kong_api" "api" {
 	name 	= "TestApi"
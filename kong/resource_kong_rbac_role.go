@@ -0,0 +1,115 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const rbacRolesPath = "/rbac/roles/"
+
+type rbacRoleRequest struct {
+	Name    string  `json:"name,omitempty"`
+	Comment *string `json:"comment,omitempty"`
+}
+
+type rbacRole struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Comment string `json:"comment"`
+}
+
+func resourceKongRbacRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRbacRoleCreate,
+		Read:   resourceKongRbacRoleRead,
+		Delete: resourceKongRbacRoleDelete,
+		Update: resourceKongRbacRoleUpdate,
+		Importer: &schema.ResourceImporter{
+			State: importWorkspaceEntity(1),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": workspaceSchema(),
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceKongRbacRoleCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rbacRoleRequest := createKongRbacRoleRequestFromResourceData(d)
+
+	rbacRole := &rbacRole{}
+	err := meta.(*config).adminApi.post(workspacePath(d, rbacRolesPath), rbacRoleRequest, rbacRole)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong rbac role: %s error: %v", rbacRoleRequest.Name, err)
+	}
+
+	d.SetId(rbacRole.Id)
+
+	return resourceKongRbacRoleRead(d, meta)
+}
+
+func resourceKongRbacRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	rbacRoleRequest := createKongRbacRoleRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(workspacePath(d, rbacRolesPath+d.Id()), rbacRoleRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong rbac role: %s", err)
+	}
+
+	return resourceKongRbacRoleRead(d, meta)
+}
+
+func resourceKongRbacRoleRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The id is the role name when importing, kong accepts both
+	rbacRole := &rbacRole{}
+	found, err := meta.(*config).adminApi.get(workspacePath(d, rbacRolesPath+d.Id()), rbacRole)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong rbac role: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+	} else {
+		d.SetId(rbacRole.Id)
+		d.Set("name", rbacRole.Name)
+		d.Set("comment", rbacRole.Comment)
+	}
+
+	return nil
+}
+
+func resourceKongRbacRoleDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(workspacePath(d, rbacRolesPath+d.Id()))
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong rbac role: %v", err)
+	}
+
+	return nil
+}
+
+func createKongRbacRoleRequestFromResourceData(d *schema.ResourceData) *rbacRoleRequest {
+	return &rbacRoleRequest{
+		Name:    readStringFromResource(d, "name"),
+		Comment: readStringPtrFromResource(d, "comment"),
+	}
+}
@@ -0,0 +1,187 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// An endpoint is either a path or * for every endpoint
+var endpointRegex = regexp.MustCompile(`^(\*|/[^\s]*)$`)
+
+func resourceKongRbacRoleEndpointPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRbacRoleEndpointPermissionCreate,
+		Read:   resourceKongRbacRoleEndpointPermissionRead,
+		Delete: resourceKongRbacRoleEndpointPermissionDelete,
+		Update: resourceKongRbacRoleEndpointPermissionUpdate,
+		Importer: &schema.ResourceImporter{
+			State: importRbacRoleEndpointPermission,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role_workspace": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The workspace the role belongs to, the default workspace when not set",
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workspace": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The workspace the endpoint is in, * for every workspace, the workspace of the role when not set",
+			},
+			"endpoint": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(endpointRegex, "must be * or a path starting with /, * matches any path segment"),
+			},
+			"actions": rbacActionsSchema(),
+			"negative": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    false,
+				Default:     false,
+				Description: "Deny the actions instead of allowing them",
+			},
+		},
+	}
+}
+
+func resourceKongRbacRoleEndpointPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+
+	roleId := readStringFromResource(d, "role_id")
+
+	permissionRequest := &rbacEndpointPermissionRequest{
+		Workspace: readStringFromResource(d, "workspace"),
+		Endpoint:  readStringFromResource(d, "endpoint"),
+		Actions:   readRbacActionsFromResource(d),
+		Negative:  d.Get("negative").(bool),
+	}
+
+	permission := &rbacEndpointPermission{}
+	err := meta.(*config).adminApi.post(rbacRoleEndpointsPath(d, roleId), permissionRequest, permission)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong rbac role endpoint permission: %s error: %v", permissionRequest.Endpoint, err)
+	}
+
+	d.SetId(roleId + "/" + permission.Workspace + "/" + permission.Endpoint)
+
+	return resourceKongRbacRoleEndpointPermissionRead(d, meta)
+}
+
+func resourceKongRbacRoleEndpointPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	roleId, workspace, endpoint, err := splitRbacRoleEndpointPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	permissionRequest := &rbacEndpointPermissionRequest{
+		Actions:  readRbacActionsFromResource(d),
+		Negative: d.Get("negative").(bool),
+	}
+
+	err = meta.(*config).adminApi.patch(rbacRoleEndpointsPath(d, roleId)+rbacEndpointPermissionPath(workspace, endpoint), permissionRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong rbac role endpoint permission: %s", err)
+	}
+
+	return resourceKongRbacRoleEndpointPermissionRead(d, meta)
+}
+
+func resourceKongRbacRoleEndpointPermissionRead(d *schema.ResourceData, meta interface{}) error {
+
+	roleId, workspace, endpoint, err := splitRbacRoleEndpointPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	permission := &rbacEndpointPermission{}
+	found, err := meta.(*config).adminApi.get(rbacRoleEndpointsPath(d, roleId)+rbacEndpointPermissionPath(workspace, endpoint), permission)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong rbac role endpoint permission: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_id", roleId)
+	d.Set("workspace", permission.Workspace)
+	d.Set("endpoint", permission.Endpoint)
+	d.Set("actions", permission.Actions)
+	d.Set("negative", permission.Negative)
+
+	return nil
+}
+
+func resourceKongRbacRoleEndpointPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+
+	roleId, workspace, endpoint, err := splitRbacRoleEndpointPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*config).adminApi.delete(rbacRoleEndpointsPath(d, roleId) + rbacEndpointPermissionPath(workspace, endpoint)); err != nil {
+		return fmt.Errorf("could not delete kong rbac role endpoint permission: %v", err)
+	}
+
+	return nil
+}
+
+// importRbacRoleEndpointPermission imports an id of the form [role_workspace:]role_id/workspace/endpoint, the role
+// workspace is separated by a colon as the endpoint itself contains slashes
+func importRbacRoleEndpointPermission(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSplit := strings.SplitN(d.Id(), ":", 2)
+
+	if len(idSplit) == 2 {
+		d.Set("role_workspace", idSplit[0])
+		d.SetId(idSplit[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func rbacRoleEndpointsPath(d *schema.ResourceData, roleId string) string {
+	path := rbacRolesPath + roleId + "/endpoints"
+	if roleWorkspace := readStringFromResource(d, "role_workspace"); roleWorkspace != "" {
+		return "/" + roleWorkspace + path
+	}
+	return path
+}
+
+// rbacEndpointPermissionPath is the path of the permission below the endpoints of the role, kong takes the endpoint
+// without its leading slash
+func rbacEndpointPermissionPath(workspace string, endpoint string) string {
+	return "/" + workspace + "/" + strings.TrimPrefix(endpoint, "/")
+}
+
+func splitRbacRoleEndpointPermissionId(id string) (string, string, string, error) {
+	idSplit := strings.SplitN(id, "/", 3)
+
+	if len(idSplit) != 3 || idSplit[0] == "" || idSplit[1] == "" || idSplit[2] == "" {
+		return "", "", "", fmt.Errorf("failed to calculate rbac role endpoint permission id, should be slash separated as roleId/workspace/endpoint found: %v", id)
+	}
+
+	return idSplit[0], idSplit[1], idSplit[2], nil
+}
@@ -0,0 +1,223 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongRbacRoleEndpointPermission(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleEndpointPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacRoleEndpointPermissionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleEndpointPermissionExists("kong_rbac_role_endpoint_permission.services"),
+					testAccCheckForChildIdCorrect("kong_rbac_role.role", "kong_rbac_role_endpoint_permission.services", "role_id"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "workspace", "team-a"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "endpoint", "/services/*"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "actions.#", "2"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "negative", "false"),
+				),
+			},
+			{
+				Config: testUpdateRbacRoleEndpointPermissionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleEndpointPermissionExists("kong_rbac_role_endpoint_permission.services"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "actions.#", "1"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "negative", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacRoleEndpointPermissionImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleEndpointPermissionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateRbacRoleEndpointPermissionConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:        "kong_rbac_role_endpoint_permission.services",
+				ImportState:         true,
+				ImportStateIdPrefix: "team-a:",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccKongRbacRoleEndpointPermissionWildcard(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleEndpointPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacRoleWildcardEndpointPermissionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleEndpointPermissionExists("kong_rbac_role_endpoint_permission.services"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "workspace", "*"),
+					resource.TestCheckResourceAttr("kong_rbac_role_endpoint_permission.services", "endpoint", "*"),
+				),
+			},
+			{
+				ResourceName:        "kong_rbac_role_endpoint_permission.services",
+				ImportState:         true,
+				ImportStateIdPrefix: "team-a:",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccKongRbacRoleEndpointPermissionRejectsUnknownAction(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRbacRoleEndpointPermissionUnknownActionConfig,
+				ExpectError: regexp.MustCompile(`expected actions.\d+ to be one of \[read create update delete\]`),
+			},
+		},
+	})
+}
+
+func testAccCheckKongRbacRoleEndpointPermissionDestroy(state *terraform.State) error {
+
+	permissions := getResourcesByType("kong_rbac_role_endpoint_permission", state)
+
+	if len(permissions) != 1 {
+		return fmt.Errorf("expecting only 1 rbac role endpoint permission resource found %v", len(permissions))
+	}
+
+	found, err := rbacRoleEndpointPermissionExists(permissions[0])
+
+	if err != nil {
+		return fmt.Errorf("error calling get rbac role endpoint permission: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("rbac role endpoint permission %s still exists", permissions[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongRbacRoleEndpointPermissionExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := rbacRoleEndpointPermissionExists(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("rbac role endpoint permission with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func rbacRoleEndpointPermissionExists(rs *terraform.ResourceState) (bool, error) {
+	roleId, workspace, endpoint, err := splitRbacRoleEndpointPermissionId(rs.Primary.ID)
+
+	if err != nil {
+		return false, err
+	}
+
+	path := rbacRolesPath + roleId + "/endpoints" + rbacEndpointPermissionPath(workspace, endpoint)
+	if roleWorkspace := rs.Primary.Attributes["role_workspace"]; roleWorkspace != "" {
+		path = "/" + roleWorkspace + path
+	}
+
+	return testAccProvider.Meta().(*config).adminApi.get(path, &rbacEndpointPermission{})
+}
+
+const testCreateRbacRoleEndpointPermissionConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "deployer"
+}
+
+resource "kong_rbac_role_endpoint_permission" "services" {
+	role_workspace = "${kong_workspace.workspace.name}"
+	role_id        = "${kong_rbac_role.role.id}"
+	endpoint       = "/services/*"
+	actions        = ["read", "update"]
+}
+`
+const testUpdateRbacRoleEndpointPermissionConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "deployer"
+}
+
+resource "kong_rbac_role_endpoint_permission" "services" {
+	role_workspace = "${kong_workspace.workspace.name}"
+	role_id        = "${kong_rbac_role.role.id}"
+	endpoint       = "/services/*"
+	actions        = ["delete"]
+	negative       = true
+}
+`
+const testCreateRbacRoleWildcardEndpointPermissionConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "admin"
+}
+
+resource "kong_rbac_role_endpoint_permission" "services" {
+	role_workspace = "${kong_workspace.workspace.name}"
+	role_id        = "${kong_rbac_role.role.id}"
+	workspace      = "*"
+	endpoint       = "*"
+	actions        = ["read"]
+}
+`
+const testRbacRoleEndpointPermissionUnknownActionConfig = `
+resource "kong_rbac_role_endpoint_permission" "services" {
+	role_id  = "deployer"
+	endpoint = "/services/*"
+	actions  = ["read", "write"]
+}
+`
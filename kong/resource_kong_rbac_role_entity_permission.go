@@ -0,0 +1,142 @@
+package kong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKongRbacRoleEntityPermission() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRbacRoleEntityPermissionCreate,
+		Read:   resourceKongRbacRoleEntityPermissionRead,
+		Delete: resourceKongRbacRoleEntityPermissionDelete,
+		Update: resourceKongRbacRoleEntityPermissionUpdate,
+		Importer: &schema.ResourceImporter{
+			State: importWorkspaceEntity(2),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": workspaceSchema(),
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entity_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"entity_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The type of the entity, kong works it out from the entity id when not set",
+			},
+			"actions": rbacActionsSchema(),
+		},
+	}
+}
+
+func resourceKongRbacRoleEntityPermissionCreate(d *schema.ResourceData, meta interface{}) error {
+
+	roleId := readStringFromResource(d, "role_id")
+
+	permissionRequest := &rbacEntityPermissionRequest{
+		EntityId:   readStringFromResource(d, "entity_id"),
+		EntityType: readStringFromResource(d, "entity_type"),
+		Actions:    readRbacActionsFromResource(d),
+	}
+
+	err := meta.(*config).adminApi.post(rbacRoleEntitiesPath(d, roleId), permissionRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong rbac role entity permission: %s error: %v", permissionRequest.EntityId, err)
+	}
+
+	d.SetId(roleId + "/" + permissionRequest.EntityId)
+
+	return resourceKongRbacRoleEntityPermissionRead(d, meta)
+}
+
+func resourceKongRbacRoleEntityPermissionUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	roleId, entityId, err := splitRbacRoleEntityPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	permissionRequest := &rbacEntityPermissionRequest{
+		Actions: readRbacActionsFromResource(d),
+	}
+
+	err = meta.(*config).adminApi.patch(rbacRoleEntitiesPath(d, roleId)+"/"+entityId, permissionRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong rbac role entity permission: %s", err)
+	}
+
+	return resourceKongRbacRoleEntityPermissionRead(d, meta)
+}
+
+func resourceKongRbacRoleEntityPermissionRead(d *schema.ResourceData, meta interface{}) error {
+
+	roleId, entityId, err := splitRbacRoleEntityPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	permission := &rbacEntityPermission{}
+	found, err := meta.(*config).adminApi.get(rbacRoleEntitiesPath(d, roleId)+"/"+entityId, permission)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong rbac role entity permission: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("role_id", roleId)
+	d.Set("entity_id", permission.EntityId)
+	d.Set("entity_type", permission.EntityType)
+	d.Set("actions", permission.Actions)
+
+	return nil
+}
+
+func resourceKongRbacRoleEntityPermissionDelete(d *schema.ResourceData, meta interface{}) error {
+
+	roleId, entityId, err := splitRbacRoleEntityPermissionId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*config).adminApi.delete(rbacRoleEntitiesPath(d, roleId) + "/" + entityId); err != nil {
+		return fmt.Errorf("could not delete kong rbac role entity permission: %v", err)
+	}
+
+	return nil
+}
+
+func rbacRoleEntitiesPath(d *schema.ResourceData, roleId string) string {
+	return workspacePath(d, rbacRolesPath+roleId+"/entities")
+}
+
+func splitRbacRoleEntityPermissionId(id string) (string, string, error) {
+	idSplit := strings.Split(id, "/")
+
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("failed to calculate rbac role entity permission id, should be slash separated as roleId/entityId found: %v", id)
+	}
+
+	return idSplit[0], idSplit[1], nil
+}
@@ -0,0 +1,152 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongRbacRoleEntityPermission(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleEntityPermissionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacRoleEntityPermissionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleEntityPermissionExists("kong_rbac_role_entity_permission.service"),
+					testAccCheckForChildIdCorrect("kong_rbac_role.role", "kong_rbac_role_entity_permission.service", "role_id"),
+					testAccCheckForChildIdCorrect("kong_service.service", "kong_rbac_role_entity_permission.service", "entity_id"),
+					resource.TestCheckResourceAttr("kong_rbac_role_entity_permission.service", "entity_type", "services"),
+					resource.TestCheckResourceAttr("kong_rbac_role_entity_permission.service", "actions.#", "1"),
+				),
+			},
+			{
+				Config: testUpdateRbacRoleEntityPermissionConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleEntityPermissionExists("kong_rbac_role_entity_permission.service"),
+					resource.TestCheckResourceAttr("kong_rbac_role_entity_permission.service", "actions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacRoleEntityPermissionImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleEntityPermissionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateRbacRoleEntityPermissionConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_rbac_role_entity_permission.service",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongRbacRoleEntityPermissionDestroy(state *terraform.State) error {
+
+	permissions := getResourcesByType("kong_rbac_role_entity_permission", state)
+
+	if len(permissions) != 1 {
+		return fmt.Errorf("expecting only 1 rbac role entity permission resource found %v", len(permissions))
+	}
+
+	found, err := rbacRoleEntityPermissionExists(permissions[0])
+
+	if err != nil {
+		return fmt.Errorf("error calling get rbac role entity permission: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("rbac role entity permission %s still exists", permissions[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongRbacRoleEntityPermissionExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := rbacRoleEntityPermissionExists(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("rbac role entity permission with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func rbacRoleEntityPermissionExists(rs *terraform.ResourceState) (bool, error) {
+	roleId, entityId, err := splitRbacRoleEntityPermissionId(rs.Primary.ID)
+
+	if err != nil {
+		return false, err
+	}
+
+	path := testWorkspaceEntityPath(rs, rbacRolesPath+roleId+"/entities/"+entityId)
+
+	return testAccProvider.Meta().(*config).adminApi.get(path, &rbacEntityPermission{})
+}
+
+const testCreateRbacRoleEntityPermissionConfig = `
+resource "kong_service" "service" {
+	name     = "payments"
+	protocol = "http"
+	host     = "payments.internal"
+}
+
+resource "kong_rbac_role" "role" {
+	name = "payments-reader"
+}
+
+resource "kong_rbac_role_entity_permission" "service" {
+	role_id   = "${kong_rbac_role.role.id}"
+	entity_id = "${kong_service.service.id}"
+	actions   = ["read"]
+}
+`
+const testUpdateRbacRoleEntityPermissionConfig = `
+resource "kong_service" "service" {
+	name     = "payments"
+	protocol = "http"
+	host     = "payments.internal"
+}
+
+resource "kong_rbac_role" "role" {
+	name = "payments-reader"
+}
+
+resource "kong_rbac_role_entity_permission" "service" {
+	role_id   = "${kong_rbac_role.role.id}"
+	entity_id = "${kong_service.service.id}"
+	actions   = ["read", "update", "delete"]
+}
+`
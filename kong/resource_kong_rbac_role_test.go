@@ -0,0 +1,131 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongRbacRole(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleExists("kong_rbac_role.role"),
+					resource.TestCheckResourceAttr("kong_rbac_role.role", "name", "deployer"),
+					resource.TestCheckResourceAttr("kong_rbac_role.role", "comment", "can deploy services"),
+					resource.TestCheckResourceAttr("kong_rbac_role.role", "workspace", "team-a"),
+				),
+			},
+			{
+				Config: testUpdateRbacRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacRoleExists("kong_rbac_role.role"),
+					resource.TestCheckResourceAttr("kong_rbac_role.role", "name", "service-deployer"),
+					resource.TestCheckResourceAttr("kong_rbac_role.role", "comment", "can deploy services and routes"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacRoleImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateRbacRoleConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:        "kong_rbac_role.role",
+				ImportState:         true,
+				ImportStateIdPrefix: "team-a/",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongRbacRoleDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	roles := getResourcesByType("kong_rbac_role", state)
+
+	if len(roles) != 1 {
+		return fmt.Errorf("expecting only 1 rbac role resource found %v", len(roles))
+	}
+
+	found, err := client.get(testWorkspaceEntityPath(roles[0], rbacRolesPath+roles[0].Primary.ID), &rbacRole{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get rbac role by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("rbac role %s still exists", roles[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongRbacRoleExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(testWorkspaceEntityPath(rs, rbacRolesPath+rs.Primary.ID), &rbacRole{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("rbac role with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateRbacRoleConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "deployer"
+	comment   = "can deploy services"
+}
+`
+const testUpdateRbacRoleConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "service-deployer"
+	comment   = "can deploy services and routes"
+}
+`
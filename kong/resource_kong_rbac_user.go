@@ -0,0 +1,139 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+const rbacUsersPath = "/rbac/users/"
+
+type rbacUserRequest struct {
+	Name      string  `json:"name,omitempty"`
+	UserToken string  `json:"user_token,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+	Comment   *string `json:"comment,omitempty"`
+}
+
+type rbacUser struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	Comment string `json:"comment"`
+}
+
+func resourceKongRbacUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRbacUserCreate,
+		Read:   resourceKongRbacUserRead,
+		Delete: resourceKongRbacUserDelete,
+		Update: resourceKongRbacUserUpdate,
+		Importer: &schema.ResourceImporter{
+			State: importWorkspaceEntity(1),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": workspaceSchema(),
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: false,
+			},
+			// Kong only keeps a hash of the token so it is never read back
+			"user_token": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  false,
+				Sensitive: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+		},
+	}
+}
+
+func resourceKongRbacUserCreate(d *schema.ResourceData, meta interface{}) error {
+
+	rbacUserRequest := createKongRbacUserRequestFromResourceData(d)
+	rbacUserRequest.UserToken = readStringFromResource(d, "user_token")
+
+	rbacUser := &rbacUser{}
+	err := meta.(*config).adminApi.post(workspacePath(d, rbacUsersPath), rbacUserRequest, rbacUser)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong rbac user: %s error: %v", rbacUserRequest.Name, err)
+	}
+
+	d.SetId(rbacUser.Id)
+
+	return resourceKongRbacUserRead(d, meta)
+}
+
+func resourceKongRbacUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	rbacUserRequest := createKongRbacUserRequestFromResourceData(d)
+
+	if d.HasChange("user_token") {
+		rbacUserRequest.UserToken = readStringFromResource(d, "user_token")
+	}
+
+	err := meta.(*config).adminApi.patch(workspacePath(d, rbacUsersPath+d.Id()), rbacUserRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong rbac user: %s", err)
+	}
+
+	return resourceKongRbacUserRead(d, meta)
+}
+
+func resourceKongRbacUserRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The id is the user name when importing, kong accepts both
+	rbacUser := &rbacUser{}
+	found, err := meta.(*config).adminApi.get(workspacePath(d, rbacUsersPath+d.Id()), rbacUser)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong rbac user: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+	} else {
+		d.SetId(rbacUser.Id)
+		d.Set("name", rbacUser.Name)
+		d.Set("enabled", rbacUser.Enabled)
+		d.Set("comment", rbacUser.Comment)
+	}
+
+	return nil
+}
+
+func resourceKongRbacUserDelete(d *schema.ResourceData, meta interface{}) error {
+
+	err := meta.(*config).adminApi.delete(workspacePath(d, rbacUsersPath+d.Id()))
+
+	if err != nil {
+		return fmt.Errorf("could not delete kong rbac user: %v", err)
+	}
+
+	return nil
+}
+
+func createKongRbacUserRequestFromResourceData(d *schema.ResourceData) *rbacUserRequest {
+	return &rbacUserRequest{
+		Name:    readStringFromResource(d, "name"),
+		Enabled: gokong.Bool(d.Get("enabled").(bool)),
+		Comment: readStringPtrFromResource(d, "comment"),
+	}
+}
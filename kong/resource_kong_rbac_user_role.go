@@ -0,0 +1,161 @@
+package kong
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Kong attaches roles to a user by name, the names are comma separated when more than one is sent
+type rbacUserRolesRequest struct {
+	Roles string `json:"roles"`
+}
+
+type rbacUserRoles struct {
+	Roles []rbacRole `json:"roles"`
+}
+
+func resourceKongRbacUserRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongRbacUserRoleCreate,
+		Read:   resourceKongRbacUserRoleRead,
+		Delete: resourceKongRbacUserRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: importWorkspaceEntity(2),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace": workspaceSchema(),
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceKongRbacUserRoleCreate(d *schema.ResourceData, meta interface{}) error {
+
+	userId := readStringFromResource(d, "user_id")
+	roleId := readStringFromResource(d, "role_id")
+
+	roleName, err := getRbacRoleName(d, meta, roleId)
+
+	if err != nil {
+		return err
+	}
+
+	if roleName == "" {
+		return fmt.Errorf("could not find kong rbac role: %s", roleId)
+	}
+
+	err = meta.(*config).adminApi.post(rbacUserRolesPath(d, userId), &rbacUserRolesRequest{Roles: roleName}, nil)
+
+	if err != nil {
+		return fmt.Errorf("failed to add kong rbac role: %s to rbac user: %s error: %v", roleId, userId, err)
+	}
+
+	d.SetId(userId + "/" + roleId)
+
+	return resourceKongRbacUserRoleRead(d, meta)
+}
+
+func resourceKongRbacUserRoleRead(d *schema.ResourceData, meta interface{}) error {
+
+	userId, roleId, err := splitRbacUserRoleId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	userRoles := &rbacUserRoles{}
+	found, err := meta.(*config).adminApi.get(rbacUserRolesPath(d, userId), userRoles)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong rbac user role: %v", err)
+	}
+
+	if !found || !rbacRolesContain(userRoles.Roles, roleId) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", userId)
+	d.Set("role_id", roleId)
+
+	return nil
+}
+
+func resourceKongRbacUserRoleDelete(d *schema.ResourceData, meta interface{}) error {
+
+	userId, roleId, err := splitRbacUserRoleId(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	roleName, err := getRbacRoleName(d, meta, roleId)
+
+	if err != nil {
+		return err
+	}
+
+	if roleName == "" {
+		return nil
+	}
+
+	status, err := meta.(*config).adminApi.do(http.MethodDelete, rbacUserRolesPath(d, userId), &rbacUserRolesRequest{Roles: roleName}, nil)
+
+	if err != nil && status != http.StatusNotFound {
+		return fmt.Errorf("could not delete kong rbac user role: %v", err)
+	}
+
+	return nil
+}
+
+// getRbacRoleName returns the name of the role or an empty string when the role no longer exists
+func getRbacRoleName(d *schema.ResourceData, meta interface{}, roleId string) (string, error) {
+	role := &rbacRole{}
+	found, err := meta.(*config).adminApi.get(workspacePath(d, rbacRolesPath+roleId), role)
+
+	if err != nil {
+		return "", fmt.Errorf("could not find kong rbac role: %v", err)
+	}
+
+	if !found {
+		return "", nil
+	}
+
+	return role.Name, nil
+}
+
+func rbacRolesContain(roles []rbacRole, roleId string) bool {
+	for _, role := range roles {
+		if role.Id == roleId || role.Name == roleId {
+			return true
+		}
+	}
+	return false
+}
+
+func rbacUserRolesPath(d *schema.ResourceData, userId string) string {
+	return workspacePath(d, rbacUsersPath+userId+"/roles")
+}
+
+func splitRbacUserRoleId(id string) (string, string, error) {
+	idSplit := strings.Split(id, "/")
+
+	if len(idSplit) != 2 || idSplit[0] == "" || idSplit[1] == "" {
+		return "", "", fmt.Errorf("failed to calculate rbac user role id, should be slash separated as userId/roleId found: %v", id)
+	}
+
+	return idSplit[0], idSplit[1], nil
+}
@@ -0,0 +1,137 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongRbacUserRole(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacUserRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacUserRoleConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacUserRoleExists("kong_rbac_user_role.user_role"),
+					testAccCheckForChildIdCorrect("kong_rbac_user.user", "kong_rbac_user_role.user_role", "user_id"),
+					testAccCheckForChildIdCorrect("kong_rbac_role.role", "kong_rbac_user_role.user_role", "role_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacUserRoleImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacUserRoleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateRbacUserRoleConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:        "kong_rbac_user_role.user_role",
+				ImportState:         true,
+				ImportStateIdPrefix: "team-a/",
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongRbacUserRoleDestroy(state *terraform.State) error {
+
+	userRoles := getResourcesByType("kong_rbac_user_role", state)
+
+	if len(userRoles) != 1 {
+		return fmt.Errorf("expecting only 1 rbac user role resource found %v", len(userRoles))
+	}
+
+	found, err := rbacUserRoleExists(userRoles[0])
+
+	if err != nil {
+		return fmt.Errorf("error calling get rbac user roles: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("rbac user role %s still exists", userRoles[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongRbacUserRoleExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := rbacUserRoleExists(rs)
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("rbac user role with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func rbacUserRoleExists(rs *terraform.ResourceState) (bool, error) {
+	userId, roleId, err := splitRbacUserRoleId(rs.Primary.ID)
+
+	if err != nil {
+		return false, err
+	}
+
+	userRoles := &rbacUserRoles{}
+	found, err := testAccProvider.Meta().(*config).adminApi.get(testWorkspaceEntityPath(rs, rbacUsersPath+userId+"/roles"), userRoles)
+
+	if err != nil {
+		return false, err
+	}
+
+	return found && rbacRolesContain(userRoles.Roles, roleId), nil
+}
+
+const testCreateRbacUserRoleConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_user" "user" {
+	workspace  = "${kong_workspace.workspace.name}"
+	name       = "team-a-pipeline"
+	user_token = "team-a-token"
+}
+
+resource "kong_rbac_role" "role" {
+	workspace = "${kong_workspace.workspace.name}"
+	name      = "deployer"
+}
+
+resource "kong_rbac_user_role" "user_role" {
+	workspace = "${kong_workspace.workspace.name}"
+	user_id   = "${kong_rbac_user.user.id}"
+	role_id   = "${kong_rbac_role.role.id}"
+}
+`
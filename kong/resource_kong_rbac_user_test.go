@@ -0,0 +1,164 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongRbacUser(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacUserExists("kong_rbac_user.user"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "name", "pipeline"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "enabled", "true"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "comment", "used by the deploy pipeline"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "workspace", ""),
+				),
+			},
+			{
+				Config: testUpdateRbacUserConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacUserExists("kong_rbac_user.user"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "name", "pipeline"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "enabled", "false"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "comment", "disabled until rotated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacUserInWorkspace(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateRbacUserInWorkspaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongRbacUserExists("kong_rbac_user.user"),
+					resource.TestCheckResourceAttr("kong_rbac_user.user", "workspace", "team-a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongRbacUserImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongRbacUserDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateRbacUserInWorkspaceConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:            "kong_rbac_user.user",
+				ImportState:             true,
+				ImportStateIdPrefix:     "team-a/",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"user_token"},
+			},
+		},
+	})
+}
+
+func testAccCheckKongRbacUserDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	users := getResourcesByType("kong_rbac_user", state)
+
+	if len(users) != 1 {
+		return fmt.Errorf("expecting only 1 rbac user resource found %v", len(users))
+	}
+
+	found, err := client.get(testWorkspaceEntityPath(users[0], rbacUsersPath+users[0].Primary.ID), &rbacUser{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get rbac user by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("rbac user %s still exists", users[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongRbacUserExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(testWorkspaceEntityPath(rs, rbacUsersPath+rs.Primary.ID), &rbacUser{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("rbac user with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// testWorkspaceEntityPath prefixes path with the workspace the resource was created in
+func testWorkspaceEntityPath(rs *terraform.ResourceState, path string) string {
+	if workspace := rs.Primary.Attributes["workspace"]; workspace != "" {
+		return "/" + workspace + path
+	}
+	return path
+}
+
+const testCreateRbacUserConfig = `
+resource "kong_rbac_user" "user" {
+	name       = "pipeline"
+	user_token = "pipeline-token"
+	comment    = "used by the deploy pipeline"
+}
+`
+const testUpdateRbacUserConfig = `
+resource "kong_rbac_user" "user" {
+	name       = "pipeline"
+	user_token = "rotated-pipeline-token"
+	enabled    = false
+	comment    = "disabled until rotated"
+}
+`
+const testCreateRbacUserInWorkspaceConfig = `
+resource "kong_workspace" "workspace" {
+	name = "team-a"
+}
+
+resource "kong_rbac_user" "user" {
+	workspace  = "${kong_workspace.workspace.name}"
+	name       = "team-a-pipeline"
+	user_token = "team-a-token"
+}
+`
@@ -2,17 +2,23 @@ package kong
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/kevholditch/gokong"
 )
 
+const targetDrainPollInterval = time.Second
+
 func resourceKongTarget() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceKongTargetCreate,
 		Read:   resourceKongTargetRead,
 		Delete: resourceKongTargetDelete,
+		Update: resourceKongTargetUpdate,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -26,13 +32,35 @@ func resourceKongTarget() *schema.Resource {
 			"weight": &schema.Schema{
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
+				ForceNew: false,
 			},
 			"upstream_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"drain_on_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    false,
+				Default:     false,
+				Description: "Set the weight to 0 and wait for drain_timeout before deleting the target",
+			},
+			"drain_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     false,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Seconds to wait for requests to the target to finish before deleting it",
+			},
+			"drain_until_idle": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    false,
+				Default:     false,
+				Description: "Stop waiting before drain_timeout once kong reports no requests in flight",
+			},
 		},
 	}
 }
@@ -52,6 +80,38 @@ func resourceKongTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceKongTargetRead(d, meta)
 }
 
+func resourceKongTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	// The drain settings are only used on destroy and are not sent to kong
+	if !d.HasChange("weight") {
+		return resourceKongTargetRead(d, meta)
+	}
+
+	var ids = strings.Split(d.Id(), "/")
+
+	targetRequest := &upstreamTargetRequest{
+		Target: readStringFromResource(d, "target"),
+		Weight: readIntFromResource(d, "weight"),
+	}
+
+	// Older versions of kong add a new entry for the address rather than changing the existing one, the new entry
+	// replaces the old one as soon as it is created so the target is never missing from the upstream
+	target, err := updateUpstreamTarget(meta, ids[0], &upstreamTarget{Id: ids[1]}, targetRequest)
+
+	if err != nil {
+		return err
+	}
+
+	if target == nil {
+		return fmt.Errorf("could not find kong target: %s", d.Id())
+	}
+
+	d.SetId(ids[0] + "/" + target.Id)
+
+	return resourceKongTargetRead(d, meta)
+}
+
 func resourceKongTargetRead(d *schema.ResourceData, meta interface{}) error {
 
 	var ids = strings.Split(d.Id(), "/")
@@ -86,13 +146,67 @@ func resourceKongTargetRead(d *schema.ResourceData, meta interface{}) error {
 func resourceKongTargetDelete(d *schema.ResourceData, meta interface{}) error {
 
 	var ids = strings.Split(d.Id(), "/")
-	if err := meta.(*config).adminClient.Targets().DeleteFromUpstreamById(ids[0], ids[1]); err != nil {
-		return fmt.Errorf("could not delete kong target: %v", err)
+
+	if d.Get("drain_on_destroy").(bool) {
+		targetId, err := drainTarget(d, meta, ids[0], ids[1])
+		if err != nil {
+			return err
+		}
+		ids[1] = targetId
+	}
+
+	if err := deleteUpstreamTarget(meta, ids[0], ids[1]); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// drainTarget stops kong sending new requests to the target and waits for the ones in flight to finish. It returns
+// the id of the target to delete as older versions of kong create a new entry when the weight changes.
+func drainTarget(d *schema.ResourceData, meta interface{}, upstreamId string, targetId string) (string, error) {
+	targetRequest := &upstreamTargetRequest{
+		Target: readStringFromResource(d, "target"),
+		Weight: 0,
+	}
+
+	target, err := updateUpstreamTarget(meta, upstreamId, &upstreamTarget{Id: targetId}, targetRequest)
+
+	if err != nil {
+		return "", fmt.Errorf("could not drain kong target: %v", err)
+	}
+
+	// The target was already removed, there is nothing to drain
+	if target == nil {
+		return targetId, nil
+	}
+
+	deadline := time.Now().Add(time.Duration(d.Get("drain_timeout").(int)) * time.Second)
+	untilIdle := d.Get("drain_until_idle").(bool)
+
+	for time.Now().Before(deadline) {
+		if untilIdle && kongIsIdle(meta) {
+			break
+		}
+		time.Sleep(targetDrainPollInterval)
+	}
+
+	return target.Id, nil
+}
+
+// kongIsIdle tells whether kong has no requests in flight other than the status request itself. Kong only reports
+// connections for the whole node, so requests to other upstreams keep the drain waiting until its timeout.
+func kongIsIdle(meta interface{}) bool {
+	status, err := meta.(*config).adminClient.Status().Get()
+
+	if err != nil {
+		log.Printf("[WARN] could not read kong status while draining target: %v", err)
+		return false
+	}
+
+	return status.Server.ConnectionsReading+status.Server.ConnectionsWriting <= 1
+}
+
 func createKongTargetRequestFromResourceData(d *schema.ResourceData) *gokong.TargetRequest {
 	return &gokong.TargetRequest{
 		Target: readStringFromResource(d, "target"),
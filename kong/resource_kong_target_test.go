@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -29,6 +30,65 @@ func TestAccKongTarget(t *testing.T) {
 					testAccCheckKongTargetExists("kong_target.target"),
 					resource.TestCheckResourceAttr("kong_target.target", "target", "mytarget:4000"),
 					resource.TestCheckResourceAttr("kong_target.target", "weight", "200"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "mytarget:4000=200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongTargetUpdatesWeightInPlace(t *testing.T) {
+	skipIfKongVersionBelow(t, "2.2.0")
+
+	var targetId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					storeResourceId("kong_target.target", &targetId),
+				),
+			},
+			{
+				Config: testUpdateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					checkResourceId("kong_target.target", &targetId),
+					resource.TestCheckResourceAttr("kong_target.target", "weight", "200"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "mytarget:4000=200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongTargetUpdatesWeightWithNewEntry(t *testing.T) {
+	skipUnlessKongVersionBelow(t, targetPatchMinimumKongVersion)
+
+	var targetId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					storeResourceId("kong_target.target", &targetId),
+				),
+			},
+			{
+				Config: testUpdateTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					checkResourceIdChanged("kong_target.target", &targetId),
+					resource.TestCheckResourceAttr("kong_target.target", "weight", "200"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "mytarget:4000=200"),
 				),
 			},
 		},
@@ -59,6 +119,63 @@ func TestAccKongTargetDelete(t *testing.T) {
 	})
 }
 
+func TestAccKongTargetDrainOnDestroy(t *testing.T) {
+	// Kong before 2.2 leaves targets with a weight of 0 out of the targets of an upstream so the drain can not be seen
+	skipIfKongVersionBelow(t, targetPatchMinimumKongVersion)
+
+	var upstreamId string
+	drained := make(chan bool, 1)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateDrainingTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					resource.TestCheckResourceAttr("kong_target.target", "drain_on_destroy", "true"),
+					resource.TestCheckResourceAttr("kong_target.target", "drain_timeout", "2"),
+					resource.TestCheckResourceAttr("kong_target.target", "drain_until_idle", "false"),
+					storeResourceId("kong_upstream.upstream", &upstreamId),
+				),
+			},
+			{
+				PreConfig: watchTargetDrain(&upstreamId, "mytarget:4000", drained),
+				Config:    testDeleteTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetDoesNotExist("kong_target.target", "kong_upstream.upstream"),
+					checkTargetDrained(drained),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongTargetDrainOnDestroyWhenAlreadyDeleted(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateDrainingTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetExists("kong_target.target"),
+					deleteTarget("kong_target.target"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testDeleteTargetConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongTargetDoesNotExist("kong_target.target", "kong_upstream.upstream"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKongTargetCreateAndRefreshFromNonExistentUpstream(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -90,9 +207,10 @@ func TestAccKongTargetImport(t *testing.T) {
 			},
 
 			resource.TestStep{
-				ResourceName:      "kong_target.target",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "kong_target.target",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"drain_on_destroy", "drain_timeout", "drain_until_idle"},
 			},
 		},
 	})
@@ -209,6 +327,84 @@ func deleteUpstream(upstreamResourceKey string) resource.TestCheckFunc {
 	}
 }
 
+func checkResourceIdChanged(resourceKey string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("%s still has id %s, expected the id of the new entry", resourceKey, *id)
+		}
+
+		return nil
+	}
+}
+
+func deleteTarget(targetResourceKey string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[targetResourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", targetResourceKey)
+		}
+
+		var ids = strings.Split(rs.Primary.ID, "/")
+
+		return deleteUpstreamTarget(testAccProvider.Meta(), ids[0], ids[1])
+	}
+}
+
+// watchTargetDrain polls the targets of the upstream while the step runs and tells whether the weight of the target
+// went to 0 before it was deleted
+func watchTargetDrain(upstreamId *string, address string, drained chan<- bool) func() {
+	return func() {
+		go func() {
+			sawZeroWeight := false
+			deadline := time.Now().Add(time.Minute)
+
+			for time.Now().Before(deadline) {
+				targets, err := listUpstreamTargets(testAccProvider.Meta(), *upstreamId)
+				if err != nil {
+					break
+				}
+
+				found := false
+				for _, target := range targets {
+					if target.Target == address {
+						found = true
+						sawZeroWeight = sawZeroWeight || target.Weight == 0
+					}
+				}
+				if !found {
+					break
+				}
+
+				time.Sleep(100 * time.Millisecond)
+			}
+
+			drained <- sawZeroWeight
+		}()
+	}
+}
+
+func checkTargetDrained(drained <-chan bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		select {
+		case sawZeroWeight := <-drained:
+			if !sawZeroWeight {
+				return fmt.Errorf("target was deleted without its weight being set to 0 first")
+			}
+		case <-time.After(time.Minute):
+			return fmt.Errorf("timed out watching the target drain")
+		}
+
+		return nil
+	}
+}
+
 const testCreateTargetConfig = `
 resource "kong_upstream" "upstream" {
 	name				= "MyUpstream"
@@ -239,3 +435,18 @@ resource "kong_upstream" "upstream" {
 	slots				= 10
 }
 `
+const testCreateDrainingTargetConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 10
+}
+
+resource "kong_target" "target" {
+	target           = "mytarget:4000"
+	weight           = 100
+	upstream_id      = "${kong_upstream.upstream.id}"
+	drain_on_destroy = true
+	drain_timeout    = 2
+	drain_until_idle = false
+}
+`
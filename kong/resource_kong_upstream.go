@@ -9,12 +9,13 @@ import (
 
 func resourceKongUpstream() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceKongUpstreamCreate,
-		Read:   resourceKongUpstreamRead,
-		Delete: resourceKongUpstreamDelete,
-		Update: resourceKongUpstreamUpdate,
+		Create:        resourceKongUpstreamCreate,
+		Read:          resourceKongUpstreamRead,
+		Delete:        resourceKongUpstreamDelete,
+		Update:        resourceKongUpstreamUpdate,
+		CustomizeDiff: validateUpstreamTargetWeights,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importUpstream,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,6 +66,15 @@ func resourceKongUpstream() *schema.Resource {
 				ForceNew: false,
 				Default:  "/",
 			},
+			"target": upstreamTargetsSchema(),
+			// Target blocks always manage the targets, this makes the upstream manage them without any blocks too so
+			// that all its targets are removed. Otherwise the targets are left to kong_target resources.
+			"manage_targets": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: false,
+				Default:  false,
+			},
 			"healthchecks": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
@@ -262,6 +272,12 @@ func resourceKongUpstreamCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(upstream.Id)
 
+	if targets := readUpstreamTargetsFromResource(d); upstreamManagesTargets(d, targets) {
+		if err := reconcileUpstreamTargets(meta, upstream.Id, targets); err != nil {
+			return err
+		}
+	}
+
 	return resourceKongUpstreamRead(d, meta)
 }
 
@@ -276,6 +292,13 @@ func resourceKongUpstreamUpdate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error updating kong upstream: %s", err)
 	}
 
+	targets := readUpstreamTargetsFromResource(d)
+	if d.HasChanges("target", "manage_targets") && upstreamManagesTargets(d, targets) {
+		if err := reconcileUpstreamTargets(meta, d.Id(), targets); err != nil {
+			return fmt.Errorf("error updating kong upstream: %s", err)
+		}
+	}
+
 	return resourceKongUpstreamRead(d, meta)
 }
 
@@ -301,11 +324,39 @@ func resourceKongUpstreamRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("healthchecks", flattenHealthCheck(upstream.HealthChecks)); err != nil {
 			return err
 		}
+
+		// Targets are only read when the upstream manages them, otherwise they belong to kong_target resources
+		if configured := readUpstreamTargetsFromResource(d); upstreamManagesTargets(d, configured) {
+			targets, err := listUpstreamTargets(meta, d.Id())
+			if err != nil {
+				return fmt.Errorf("could not find kong upstream targets: %v", err)
+			}
+			if err := d.Set("target", flattenUpstreamTargets(targets, configured)); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+// importUpstream brings in the targets of the upstream as target blocks when it has any
+func importUpstream(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	targets, err := listUpstreamTargets(meta, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("could not find kong upstream targets: %v", err)
+	}
+
+	d.Set("manage_targets", len(targets) > 0)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func upstreamManagesTargets(d *schema.ResourceData, targets []*upstreamTargetRequest) bool {
+	return d.Get("manage_targets").(bool) || len(targets) > 0
+}
+
 func resourceKongUpstreamDelete(d *schema.ResourceData, meta interface{}) error {
 
 	err := meta.(*config).adminClient.Upstreams().DeleteById(d.Id())
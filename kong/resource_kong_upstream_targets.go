@@ -0,0 +1,83 @@
+package kong
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceKongUpstreamTargets() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceKongUpstreamTargetsCreate,
+		Read:          resourceKongUpstreamTargetsRead,
+		Delete:        resourceKongUpstreamTargetsDelete,
+		Update:        resourceKongUpstreamTargetsUpdate,
+		CustomizeDiff: validateUpstreamTargetWeights,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"upstream_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target": upstreamTargetsSchema(),
+		},
+	}
+}
+
+func resourceKongUpstreamTargetsCreate(d *schema.ResourceData, meta interface{}) error {
+
+	upstreamId := readStringFromResource(d, "upstream_id")
+
+	if err := reconcileUpstreamTargets(meta, upstreamId, readUpstreamTargetsFromResource(d)); err != nil {
+		return err
+	}
+
+	d.SetId(upstreamId)
+
+	return resourceKongUpstreamTargetsRead(d, meta)
+}
+
+func resourceKongUpstreamTargetsUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	if err := reconcileUpstreamTargets(meta, d.Id(), readUpstreamTargetsFromResource(d)); err != nil {
+		return fmt.Errorf("error updating kong upstream targets: %s", err)
+	}
+
+	return resourceKongUpstreamTargetsRead(d, meta)
+}
+
+func resourceKongUpstreamTargetsRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The targets are gone together with the upstream
+	if upstream, _ := meta.(*config).adminClient.Upstreams().GetById(d.Id()); upstream == nil {
+		d.SetId("")
+		return nil
+	}
+
+	targets, err := listUpstreamTargets(meta, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("could not find kong upstream targets: %v", err)
+	}
+
+	d.Set("upstream_id", d.Id())
+	if err := d.Set("target", flattenUpstreamTargets(targets, readUpstreamTargetsFromResource(d))); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceKongUpstreamTargetsDelete(d *schema.ResourceData, meta interface{}) error {
+
+	if err := reconcileUpstreamTargets(meta, d.Id(), nil); err != nil {
+		return fmt.Errorf("could not delete kong upstream targets: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongUpstreamTargets(t *testing.T) {
+	var targetId string
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamTargetsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUpstreamTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckForChildIdCorrect("kong_upstream.upstream", "kong_upstream_targets.targets", "upstream_id"),
+					resource.TestCheckResourceAttr("kong_upstream_targets.targets", "target.#", "2"),
+					testAccCheckKongUpstreamTargets("kong_upstream_targets.targets", "backend-a:4000=100", "backend-b:4000=100"),
+					storeUpstreamTargetId("kong_upstream_targets.targets", "backend-a:4000", &targetId),
+				),
+			},
+			{
+				Config: testUpdateUpstreamTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("kong_upstream_targets.targets", "target.#", "2"),
+					testAccCheckKongUpstreamTargets("kong_upstream_targets.targets", "backend-a:4000=20", "backend-c:4000=80"),
+					checkUpstreamTargetUpdatedInPlace("kong_upstream_targets.targets", "backend-a:4000", &targetId),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongUpstreamTargetsRemovesUnmanagedTargets(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamTargetsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUpstreamTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					addTargetToUpstream("kong_upstream.upstream", "unmanaged:4000"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testCreateUpstreamTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamTargets("kong_upstream_targets.targets", "backend-a:4000=100", "backend-b:4000=100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongUpstreamTargetsRejectsZeroWeight(t *testing.T) {
+	skipUnlessKongVersionBelow(t, targetPatchMinimumKongVersion)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testZeroWeightUpstreamTargetsConfig,
+				ExpectError: regexp.MustCompile("targets backend-a:4000 have a weight of 0"),
+			},
+		},
+	})
+}
+
+func TestAccKongUpstreamTargetsImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamTargetsDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateUpstreamTargetsConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_upstream_targets.targets",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKongUpstreamTargetsDestroy(state *terraform.State) error {
+
+	upstreamTargets := getResourcesByType("kong_upstream_targets", state)
+
+	if len(upstreamTargets) != 1 {
+		return fmt.Errorf("expecting only 1 upstream targets resource found %v", len(upstreamTargets))
+	}
+
+	upstream, _ := testAccProvider.Meta().(*config).adminClient.Upstreams().GetById(upstreamTargets[0].Primary.ID)
+
+	if upstream == nil {
+		return nil
+	}
+
+	targets, err := listUpstreamTargets(testAccProvider.Meta(), upstreamTargets[0].Primary.ID)
+
+	if err != nil {
+		return fmt.Errorf("error calling get targets of upstream: %v", err)
+	}
+
+	if len(targets) > 0 {
+		return fmt.Errorf("upstream %s still has %v targets", upstreamTargets[0].Primary.ID, len(targets))
+	}
+
+	return nil
+}
+
+// testAccCheckKongUpstreamTargets checks kong has exactly the expected targets, each given as target=weight
+func testAccCheckKongUpstreamTargets(resourceKey string, expected ...string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		targets, err := listUpstreamTargets(testAccProvider.Meta(), rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var actual []string
+		for _, target := range targets {
+			actual = append(actual, fmt.Sprintf("%s=%d", target.Target, target.Weight))
+		}
+		sort.Strings(actual)
+		sort.Strings(expected)
+
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("expected targets %v found %v", expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func storeUpstreamTargetId(resourceKey string, address string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		target, err := getUpstreamTarget(s, resourceKey, address)
+
+		if err != nil {
+			return err
+		}
+
+		*id = target.Id
+
+		return nil
+	}
+}
+
+// checkUpstreamTargetUpdatedInPlace checks the target kept its id, kong before 2.2 always adds a new entry instead
+func checkUpstreamTargetUpdatedInPlace(resourceKey string, address string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		canPatch, err := kongVersionAtLeast(testAccProvider.Meta(), targetPatchMinimumKongVersion)
+
+		if err != nil || !canPatch {
+			return err
+		}
+
+		target, err := getUpstreamTarget(s, resourceKey, address)
+
+		if err != nil {
+			return err
+		}
+
+		if target.Id != *id {
+			return fmt.Errorf("target %s was recreated, id changed from %s to %s", address, *id, target.Id)
+		}
+
+		return nil
+	}
+}
+
+func getUpstreamTarget(s *terraform.State, resourceKey string, address string) (*upstreamTarget, error) {
+	rs, ok := s.RootModule().Resources[resourceKey]
+
+	if !ok {
+		return nil, fmt.Errorf("not found: %s", resourceKey)
+	}
+
+	targets, err := listUpstreamTargets(testAccProvider.Meta(), rs.Primary.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range targets {
+		if target.Target == address {
+			return target, nil
+		}
+	}
+
+	return nil, fmt.Errorf("target %s not found on upstream %s", address, rs.Primary.ID)
+}
+
+// addTargetToUpstream adds a target to the upstream that terraform does not know about
+func addTargetToUpstream(upstreamResourceKey string, target string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[upstreamResourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", upstreamResourceKey)
+		}
+
+		_, err := createUpstreamTarget(testAccProvider.Meta(), rs.Primary.ID, &upstreamTargetRequest{Target: target, Weight: 100})
+
+		return err
+	}
+}
+
+const testCreateUpstreamTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 10
+}
+
+resource "kong_upstream_targets" "targets" {
+	upstream_id = "${kong_upstream.upstream.id}"
+	target {
+		target = "backend-a:4000"
+	}
+	target {
+		target = "backend-b:4000"
+		weight = 100
+	}
+}
+`
+const testZeroWeightUpstreamTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 10
+}
+
+resource "kong_upstream_targets" "targets" {
+	upstream_id = "${kong_upstream.upstream.id}"
+	target {
+		target = "backend-a:4000"
+		weight = 0
+	}
+}
+`
+const testUpdateUpstreamTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 10
+}
+
+resource "kong_upstream_targets" "targets" {
+	upstream_id = "${kong_upstream.upstream.id}"
+	target {
+		target = "backend-a:4000"
+		weight = 20
+	}
+	target {
+		target = "backend-c:4000"
+		weight = 80
+	}
+}
+`
@@ -145,6 +145,69 @@ func TestAccKongUpstreamImport(t *testing.T) {
 	})
 }
 
+func TestAccKongUpstreamWithTargets(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateUpstreamWithTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "target.#", "2"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "backend-a:4000=100", "backend-b:4000=50"),
+				),
+			},
+			{
+				Config: testUpdateUpstreamWithTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "slots", "20"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "target.#", "2"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "backend-b:4000=100", "backend-c:4000=100"),
+				),
+			},
+			{
+				Config: testUpdateUpstreamRemoveTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "target.#", "0"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream", "backend-b:4000=100", "backend-c:4000=100"),
+				),
+			},
+			{
+				Config: testUpdateUpstreamClearTargetsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongUpstreamExists("kong_upstream.upstream"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "manage_targets", "true"),
+					resource.TestCheckResourceAttr("kong_upstream.upstream", "target.#", "0"),
+					testAccCheckKongUpstreamTargets("kong_upstream.upstream"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongUpstreamWithTargetsImport(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongUpstreamDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateUpstreamWithManagedTargetsConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:      "kong_upstream.upstream",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKongUpstreamDestroy(state *terraform.State) error {
 
 	client := testAccProvider.Meta().(*config).adminClient
@@ -1092,3 +1155,55 @@ resource "kong_upstream" "upstream" {
 	}
 }
 `
+const testCreateUpstreamWithTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 10
+	target {
+		target = "backend-a:4000"
+	}
+	target {
+		target = "backend-b:4000"
+		weight = 50
+	}
+}
+`
+const testUpdateUpstreamWithTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 20
+	target {
+		target = "backend-b:4000"
+	}
+	target {
+		target = "backend-c:4000"
+	}
+}
+`
+const testUpdateUpstreamClearTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name           = "MyUpstream"
+	slots          = 20
+	manage_targets = true
+}
+`
+const testCreateUpstreamWithManagedTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name           = "MyUpstream"
+	slots          = 10
+	manage_targets = true
+	target {
+		target = "backend-a:4000"
+	}
+	target {
+		target = "backend-b:4000"
+		weight = 50
+	}
+}
+`
+const testUpdateUpstreamRemoveTargetsConfig = `
+resource "kong_upstream" "upstream" {
+	name  = "MyUpstream"
+	slots = 20
+}
+`
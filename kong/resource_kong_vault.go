@@ -0,0 +1,339 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Kong 2.8 serves vaults from /vaults-beta, they moved to /vaults in kong 3.0. We try them in order.
+var vaultsPaths = []string{"/vaults/", "/vaults-beta/"}
+
+// Vault backends that can be referenced by name without declaring a vault entity
+var builtinVaults = []string{"env", "aws", "gcp", "hcv", "azure", "konnect"}
+
+var vaultPrefixRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*[a-z0-9]$`)
+
+// A reference is a whole config value of the form {vault://<prefix>/<secret>}, the secret may carry a key and query
+var vaultReferenceRegex = regexp.MustCompile(`^\{vault://([a-z][a-z0-9-]*[a-z0-9])/[^/}][^}]*\}$`)
+
+type vaultRequest struct {
+	Name        string                 `json:"name,omitempty"`
+	Prefix      string                 `json:"prefix,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Config      map[string]interface{} `json:"config,omitempty"`
+	Tags        *[]string              `json:"tags,omitempty"`
+}
+
+type vault struct {
+	Id          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Prefix      string                 `json:"prefix"`
+	Description string                 `json:"description"`
+	Config      map[string]interface{} `json:"config"`
+	Tags        []string               `json:"tags"`
+}
+
+func resourceKongVault() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongVaultCreate,
+		Read:   resourceKongVaultRead,
+		Delete: resourceKongVaultDelete,
+		Update: resourceKongVaultUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The vault backend e.g. env, aws, gcp or hcv",
+			},
+			"prefix": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				ValidateFunc: validateVaultPrefix,
+				Description:  "The prefix used to reference the vault e.g. {vault://<prefix>/<secret>}",
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"config_json": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				StateFunc:    normalizeDataJSON,
+				ValidateFunc: validateDataJSON,
+				Description:  "vault configuration in JSON format, configuration must be a valid JSON object.",
+			},
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceKongVaultCreate(d *schema.ResourceData, meta interface{}) error {
+
+	path, err := vaultsPath(meta)
+	if err != nil {
+		return err
+	}
+
+	vaultRequest, err := createKongVaultRequestFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	vault := &vault{}
+	err = meta.(*config).adminApi.post(path, vaultRequest, vault)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong vault: %s error: %v", vaultRequest.Prefix, err)
+	}
+
+	d.SetId(vault.Id)
+
+	return resourceKongVaultRead(d, meta)
+}
+
+func resourceKongVaultUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	path, err := vaultsPath(meta)
+	if err != nil {
+		return err
+	}
+
+	vaultRequest, err := createKongVaultRequestFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	err = meta.(*config).adminApi.patch(path+d.Id(), vaultRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong vault: %s", err)
+	}
+
+	return resourceKongVaultRead(d, meta)
+}
+
+func resourceKongVaultRead(d *schema.ResourceData, meta interface{}) error {
+
+	path, err := vaultsPath(meta)
+	if err != nil {
+		return err
+	}
+
+	// The id is the vault prefix when importing, kong accepts both
+	vault := &vault{}
+	found, err := meta.(*config).adminApi.get(path+d.Id(), vault)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong vault: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(vault.Id)
+	d.Set("name", vault.Name)
+	d.Set("prefix", vault.Prefix)
+	d.Set("description", vault.Description)
+	d.Set("config_json", configuredKeysJsonToString(vault.Config, readStringFromResource(d, "config_json")))
+	d.Set("tags", vault.Tags)
+
+	return nil
+}
+
+func resourceKongVaultDelete(d *schema.ResourceData, meta interface{}) error {
+
+	path, err := vaultsPath(meta)
+	if err != nil {
+		return err
+	}
+
+	if err := meta.(*config).adminApi.delete(path + d.Id()); err != nil {
+		return fmt.Errorf("could not delete kong vault: %v", err)
+	}
+
+	return nil
+}
+
+func createKongVaultRequestFromResourceData(d *schema.ResourceData) (*vaultRequest, error) {
+
+	vaultRequest := &vaultRequest{
+		Name:        readStringFromResource(d, "name"),
+		Prefix:      readStringFromResource(d, "prefix"),
+		Description: readStringPtrFromResource(d, "description"),
+		Tags:        readTagsPtrFromResource(d),
+	}
+
+	if data, ok := d.GetOk("config_json"); ok {
+		if err := json.Unmarshal([]byte(data.(string)), &vaultRequest.Config); err != nil {
+			return vaultRequest, fmt.Errorf("failed to unmarshal config_json, err: %v", err)
+		}
+	}
+
+	return vaultRequest, nil
+}
+
+// configuredKeysJsonToString only keeps the keys that are configured, kong fills in defaults for the rest which would
+// otherwise show up as a diff. Everything is kept when nothing is configured, e.g. when importing.
+func configuredKeysJsonToString(upstream map[string]interface{}, configured string) string {
+	configuredMap := map[string]interface{}{}
+	if configured != "" {
+		if err := json.Unmarshal([]byte(configured), &configuredMap); err != nil {
+			return configured
+		}
+	}
+
+	result := map[string]interface{}{}
+	for key, value := range upstream {
+		if _, ok := configuredMap[key]; ok || configured == "" {
+			result[key] = value
+		}
+	}
+
+	if len(result) == 0 && configured == "" {
+		return ""
+	}
+
+	// We know it is valid JSON at this point
+	rawJson, _ := json.Marshal(result)
+
+	return string(rawJson)
+}
+
+// vaultsPath finds the path kong serves vaults from once and keeps it on the provider config
+func vaultsPath(meta interface{}) (string, error) {
+	config := meta.(*config)
+
+	config.vaultsPathLock.Lock()
+	defer config.vaultsPathLock.Unlock()
+
+	if config.vaultsPath != "" {
+		return config.vaultsPath, nil
+	}
+
+	for _, path := range vaultsPaths {
+		found, err := config.adminApi.get(path, &map[string]interface{}{})
+		if err != nil {
+			return "", fmt.Errorf("could not read kong vaults: %v", err)
+		}
+		if found {
+			config.vaultsPath = path
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("kong vaults are not supported by this version of kong, they need 2.8 or later")
+}
+
+func validateVaultPrefix(v interface{}, k string) ([]string, []error) {
+	prefix := v.(string)
+
+	if !vaultPrefixRegex.MatchString(prefix) {
+		return nil, []error{fmt.Errorf("%s must start with a lowercase letter and only contain lowercase letters, digits and dashes, got: %s", k, prefix)}
+	}
+
+	if contains(builtinVaults, prefix) {
+		return nil, []error{fmt.Errorf("%s %s is reserved for the %s vault backend", k, prefix, prefix)}
+	}
+
+	return nil, nil
+}
+
+// vaultReferences returns the vault prefixes referenced by any string in data, nested objects and arrays included
+func vaultReferences(data interface{}) ([]string, error) {
+	var prefixes []string
+
+	switch value := data.(type) {
+	case string:
+		if !strings.HasPrefix(value, "{vault://") {
+			return nil, nil
+		}
+		match := vaultReferenceRegex.FindStringSubmatch(value)
+		if match == nil {
+			return nil, fmt.Errorf("invalid vault reference %s, expected {vault://<prefix>/<secret>}", value)
+		}
+		prefixes = append(prefixes, match[1])
+	case map[string]interface{}:
+		for _, item := range value {
+			itemPrefixes, err := vaultReferences(item)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, itemPrefixes...)
+		}
+	case []interface{}:
+		for _, item := range value {
+			itemPrefixes, err := vaultReferences(item)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, itemPrefixes...)
+		}
+	}
+
+	return prefixes, nil
+}
+
+// checkVaultReferencesDeclared makes sure every vault referenced by the config exists in kong, either as a vault
+// entity or as one of the built in backends. Kong would otherwise only fail when the plugin runs. It is made on the
+// apply rather than the plan so that a vault created in the same apply can be referenced by its prefix.
+func checkVaultReferencesDeclared(meta interface{}, pluginConfig map[string]interface{}) error {
+	prefixes, err := vaultReferences(pluginConfig)
+	if err != nil {
+		return err
+	}
+
+	var undeclared []string
+	for _, prefix := range prefixes {
+		if !contains(builtinVaults, prefix) && !contains(undeclared, prefix) {
+			undeclared = append(undeclared, prefix)
+		}
+	}
+
+	if len(undeclared) == 0 {
+		return nil
+	}
+
+	path, err := vaultsPath(meta)
+	if err != nil {
+		return err
+	}
+
+	vaults, err := meta.(*config).adminApi.list(path + "?size=1000")
+	if err != nil {
+		return fmt.Errorf("could not read kong vaults: %v", err)
+	}
+
+	for _, raw := range vaults {
+		vault := &vault{}
+		if err := json.Unmarshal(raw, vault); err != nil {
+			return fmt.Errorf("could not read kong vaults: %v", err)
+		}
+		for i, prefix := range undeclared {
+			if prefix == vault.Prefix {
+				undeclared = append(undeclared[:i], undeclared[i+1:]...)
+				break
+			}
+		}
+	}
+
+	if len(undeclared) > 0 {
+		return fmt.Errorf("config_json references vault prefixes that are not declared in kong: %s", strings.Join(undeclared, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package kong
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongVault(t *testing.T) {
+	skipIfKongVersionBelow(t, "2.8.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateVaultConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongVaultExists("kong_vault.vault"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "name", "env"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "prefix", "my-env"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "description", "environment variables"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "config_json", `{"prefix":"SECRET_"}`),
+				),
+			},
+			{
+				Config: testUpdateVaultConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongVaultExists("kong_vault.vault"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "prefix", "team-env"),
+					resource.TestCheckResourceAttr("kong_vault.vault", "config_json", `{"prefix":"TEAM_"}`),
+					resource.TestCheckResourceAttr("kong_vault.vault", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongVaultImport(t *testing.T) {
+	skipIfKongVersionBelow(t, "2.8.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongVaultDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateVaultConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:            "kong_vault.vault",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config_json"},
+			},
+		},
+	})
+}
+
+func TestAccKongPluginWithVaultReference(t *testing.T) {
+	skipIfKongVersionBelow(t, "3.0.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testPluginWithVaultReferenceConfig, "undeclared"),
+				ExpectError: regexp.MustCompile("vault prefixes that are not declared in kong: undeclared"),
+			},
+			{
+				Config: fmt.Sprintf(testPluginWithVaultReferenceConfig, "${kong_vault.vault.prefix}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.session"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongPluginWithVaultReferenceToNewVault(t *testing.T) {
+	skipIfKongVersionBelow(t, "3.0.0")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongPluginDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginWithVaultReferenceDependingOnVaultConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongPluginExists("kong_plugin.session"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongPluginRejectsInvalidVaultReference(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      fmt.Sprintf(testPluginWithVaultReferenceConfig, "Not_A_Prefix"),
+				ExpectError: regexp.MustCompile("invalid vault reference"),
+			},
+		},
+	})
+}
+
+func testAccCheckKongVaultDestroy(state *terraform.State) error {
+
+	vaults := getResourcesByType("kong_vault", state)
+
+	if len(vaults) != 1 {
+		return fmt.Errorf("expecting only 1 vault resource found %v", len(vaults))
+	}
+
+	path, err := vaultsPath(testAccProvider.Meta())
+
+	if err != nil {
+		return err
+	}
+
+	found, err := testAccProvider.Meta().(*config).adminApi.get(path+vaults[0].Primary.ID, &vault{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get vault by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("vault %s still exists", vaults[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongVaultExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		path, err := vaultsPath(testAccProvider.Meta())
+
+		if err != nil {
+			return err
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(path+rs.Primary.ID, &vault{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("vault with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testCreateVaultConfig = `
+resource "kong_vault" "vault" {
+	name        = "env"
+	prefix      = "my-env"
+	description = "environment variables"
+	config_json = <<EOT
+	{
+		"prefix": "SECRET_"
+	}
+EOT
+}
+`
+const testUpdateVaultConfig = `
+resource "kong_vault" "vault" {
+	name        = "env"
+	prefix      = "team-env"
+	description = "environment variables"
+	tags        = ["team"]
+	config_json = <<EOT
+	{
+		"prefix": "TEAM_"
+	}
+EOT
+}
+`
+const testPluginWithVaultReferenceConfig = `
+resource "kong_vault" "vault" {
+	name   = "env"
+	prefix = "my-env"
+}
+
+resource "kong_plugin" "session" {
+	name        = "session"
+	config_json = <<EOT
+	{
+		"secret": "{vault://%s/session-secret}"
+	}
+EOT
+}
+`
+
+const testPluginWithVaultReferenceDependingOnVaultConfig = `
+resource "kong_vault" "vault" {
+	name   = "env"
+	prefix = "my-env"
+}
+
+resource "kong_plugin" "session" {
+	name        = "session"
+	config_json = <<EOT
+	{
+		"secret": "{vault://my-env/session-secret}"
+	}
+EOT
+	depends_on = [kong_vault.vault]
+}
+`
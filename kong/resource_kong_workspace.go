@@ -0,0 +1,289 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+const workspacesPath = "/workspaces/"
+
+// Entities that keep a workspace from being deleted, checked before deleting so the error says what is left behind
+var workspaceEntityTypes = []string{"services", "routes", "consumers", "plugins", "upstreams", "certificates", "snis"}
+
+type workspaceConfig struct {
+	Portal            *bool   `json:"portal,omitempty"`
+	PortalAuth        *string `json:"portal_auth,omitempty"`
+	PortalAutoApprove *bool   `json:"portal_auto_approve,omitempty"`
+	PortalEmailsFrom  *string `json:"portal_emails_from,omitempty"`
+}
+
+type workspaceMeta struct {
+	Color     *string `json:"color,omitempty"`
+	Thumbnail *string `json:"thumbnail,omitempty"`
+}
+
+type workspaceRequest struct {
+	Name    string           `json:"name,omitempty"`
+	Comment *string          `json:"comment,omitempty"`
+	Config  *workspaceConfig `json:"config,omitempty"`
+	Meta    *workspaceMeta   `json:"meta,omitempty"`
+}
+
+type workspace struct {
+	Id      string           `json:"id"`
+	Name    string           `json:"name"`
+	Comment string           `json:"comment"`
+	Config  *workspaceConfig `json:"config"`
+	Meta    *workspaceMeta   `json:"meta"`
+}
+
+func resourceKongWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKongWorkspaceCreate,
+		Read:   resourceKongWorkspaceRead,
+		Delete: resourceKongWorkspaceDelete,
+		Update: resourceKongWorkspaceUpdate,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"comment": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: false,
+			},
+			"config": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"portal": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"portal_auth": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"portal_auto_approve": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"portal_emails_from": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"meta": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"color": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"thumbnail": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"force_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the workspace together with every entity it still holds",
+			},
+		},
+	}
+}
+
+func resourceKongWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+
+	workspaceRequest := createKongWorkspaceRequestFromResourceData(d)
+
+	workspace := &workspace{}
+	err := meta.(*config).adminApi.post(workspacesPath, workspaceRequest, workspace)
+
+	if err != nil {
+		return fmt.Errorf("failed to create kong workspace: %s error: %v", workspaceRequest.Name, err)
+	}
+
+	d.SetId(workspace.Id)
+
+	return resourceKongWorkspaceRead(d, meta)
+}
+
+func resourceKongWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	d.Partial(false)
+
+	workspaceRequest := createKongWorkspaceRequestFromResourceData(d)
+
+	err := meta.(*config).adminApi.patch(workspacesPath+d.Id(), workspaceRequest, nil)
+
+	if err != nil {
+		return fmt.Errorf("error updating kong workspace: %s", err)
+	}
+
+	return resourceKongWorkspaceRead(d, meta)
+}
+
+func resourceKongWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+
+	// The id is the workspace name when importing, kong accepts both
+	workspace := &workspace{}
+	found, err := meta.(*config).adminApi.get(workspacesPath+d.Id(), workspace)
+
+	if err != nil {
+		return fmt.Errorf("could not find kong workspace: %v", err)
+	}
+
+	if !found {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(workspace.Id)
+	d.Set("name", workspace.Name)
+	d.Set("comment", workspace.Comment)
+	d.Set("config", flattenWorkspaceConfig(workspace.Config))
+	d.Set("meta", flattenWorkspaceMeta(workspace.Meta))
+
+	return nil
+}
+
+func resourceKongWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+
+	path := workspacesPath + d.Id()
+
+	if d.Get("force_destroy").(bool) {
+		path += "?cascade=true"
+	} else {
+		entities, err := workspaceEntityTypesInUse(meta, readStringFromResource(d, "name"))
+		if err != nil {
+			return err
+		}
+		if len(entities) > 0 {
+			return fmt.Errorf("kong workspace %s still holds %s, remove them or set force_destroy to delete them with the workspace",
+				readStringFromResource(d, "name"), strings.Join(entities, ", "))
+		}
+	}
+
+	if err := meta.(*config).adminApi.delete(path); err != nil {
+		return fmt.Errorf("could not delete kong workspace: %v", err)
+	}
+
+	return nil
+}
+
+// workspaceEntityTypesInUse returns the entity types the workspace still holds entities of
+func workspaceEntityTypesInUse(meta interface{}, workspaceName string) ([]string, error) {
+	var entities []string
+
+	for _, entityType := range workspaceEntityTypes {
+		page := &struct {
+			Data []json.RawMessage `json:"data"`
+		}{}
+
+		found, err := meta.(*config).adminApi.get("/"+workspaceName+"/"+entityType+"?size=1", page)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s of kong workspace %s: %v", entityType, workspaceName, err)
+		}
+
+		if found && len(page.Data) > 0 {
+			entities = append(entities, entityType)
+		}
+	}
+
+	return entities, nil
+}
+
+func createKongWorkspaceRequestFromResourceData(d *schema.ResourceData) *workspaceRequest {
+
+	workspaceRequest := &workspaceRequest{
+		Name:    readStringFromResource(d, "name"),
+		Comment: readStringPtrFromResource(d, "comment"),
+	}
+
+	if configArray := readArrayFromResource(d, "config"); len(configArray) > 0 && configArray[0] != nil {
+		configMap := configArray[0].(map[string]interface{})
+		workspaceRequest.Config = &workspaceConfig{
+			Portal:            gokong.Bool(configMap["portal"].(bool)),
+			PortalAutoApprove: gokong.Bool(configMap["portal_auto_approve"].(bool)),
+		}
+		if portalAuth := configMap["portal_auth"].(string); portalAuth != "" {
+			workspaceRequest.Config.PortalAuth = gokong.String(portalAuth)
+		}
+		if emailsFrom := configMap["portal_emails_from"].(string); emailsFrom != "" {
+			workspaceRequest.Config.PortalEmailsFrom = gokong.String(emailsFrom)
+		}
+	}
+
+	if metaArray := readArrayFromResource(d, "meta"); len(metaArray) > 0 && metaArray[0] != nil {
+		metaMap := metaArray[0].(map[string]interface{})
+		workspaceRequest.Meta = &workspaceMeta{
+			Color:     gokong.String(metaMap["color"].(string)),
+			Thumbnail: gokong.String(metaMap["thumbnail"].(string)),
+		}
+	}
+
+	return workspaceRequest
+}
+
+func flattenWorkspaceConfig(in *workspaceConfig) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if in.Portal != nil {
+		m["portal"] = *in.Portal
+	}
+	if in.PortalAuth != nil {
+		m["portal_auth"] = *in.PortalAuth
+	}
+	if in.PortalAutoApprove != nil {
+		m["portal_auto_approve"] = *in.PortalAutoApprove
+	}
+	if in.PortalEmailsFrom != nil {
+		m["portal_emails_from"] = *in.PortalEmailsFrom
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWorkspaceMeta(in *workspaceMeta) []interface{} {
+	if in == nil || (in.Color == nil && in.Thumbnail == nil) {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if in.Color != nil {
+		m["color"] = *in.Color
+	}
+	if in.Thumbnail != nil {
+		m["thumbnail"] = *in.Thumbnail
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,170 @@
+package kong
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccKongWorkspace(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCreateWorkspaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongWorkspaceExists("kong_workspace.workspace"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "name", "team-a"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "comment", "owned by team a"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "meta.0.color", "#1155CC"),
+				),
+			},
+			{
+				Config: testUpdateWorkspaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongWorkspaceExists("kong_workspace.workspace"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "comment", "owned by team a and b"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "config.0.portal", "true"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "config.0.portal_auth", "basic-auth"),
+					resource.TestCheckResourceAttr("kong_workspace.workspace", "meta.0.color", "#CC1155"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongWorkspaceForceDestroy(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testForceDestroyWorkspaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKongWorkspaceExists("kong_workspace.workspace"),
+					addServiceToWorkspace("team-a"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKongWorkspaceImport(t *testing.T) {
+	skipUnlessKongEnterprise(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKongWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testCreateWorkspaceConfig,
+			},
+
+			resource.TestStep{
+				ResourceName:            "kong_workspace.workspace",
+				ImportState:             true,
+				ImportStateId:           "team-a",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func testAccCheckKongWorkspaceDestroy(state *terraform.State) error {
+
+	client := testAccProvider.Meta().(*config).adminApi
+
+	workspaces := getResourcesByType("kong_workspace", state)
+
+	if len(workspaces) != 1 {
+		return fmt.Errorf("expecting only 1 workspace resource found %v", len(workspaces))
+	}
+
+	found, err := client.get(workspacesPath+workspaces[0].Primary.ID, &workspace{})
+
+	if err != nil {
+		return fmt.Errorf("error calling get workspace by id: %v", err)
+	}
+
+	if found {
+		return fmt.Errorf("workspace %s still exists", workspaces[0].Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckKongWorkspaceExists(resourceKey string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceKey]
+
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceKey)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		found, err := testAccProvider.Meta().(*config).adminApi.get(workspacesPath+rs.Primary.ID, &workspace{})
+
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("workspace with id %v not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// addServiceToWorkspace leaves an entity behind in the workspace that terraform does not know about
+func addServiceToWorkspace(workspaceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		service := map[string]interface{}{
+			"name": "left-behind",
+			"host": "example.com",
+		}
+
+		return testAccProvider.Meta().(*config).adminApi.post("/"+workspaceName+"/services", service, nil)
+	}
+}
+
+const testCreateWorkspaceConfig = `
+resource "kong_workspace" "workspace" {
+	name    = "team-a"
+	comment = "owned by team a"
+	meta {
+		color = "#1155CC"
+	}
+}
+`
+const testUpdateWorkspaceConfig = `
+resource "kong_workspace" "workspace" {
+	name    = "team-a"
+	comment = "owned by team a and b"
+	config {
+		portal      = true
+		portal_auth = "basic-auth"
+	}
+	meta {
+		color = "#CC1155"
+	}
+}
+`
+const testForceDestroyWorkspaceConfig = `
+resource "kong_workspace" "workspace" {
+	name          = "team-a"
+	force_destroy = true
+}
+`
@@ -0,0 +1,107 @@
+package kong
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/kevholditch/gokong"
+)
+
+// Kong does not return tags in gokong's entities so they are read separately from the raw entity
+type entityTags struct {
+	Tags []string `json:"tags"`
+}
+
+func readEntityTags(raw json.RawMessage) []string {
+	tags := &entityTags{}
+	if err := json.Unmarshal(raw, tags); err != nil || tags.Tags == nil {
+		return []string{}
+	}
+	return tags.Tags
+}
+
+func tagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// readTagsFromResource always returns a slice so that removing every tag also clears them in kong
+func readTagsFromResource(d *schema.ResourceData) []string {
+	tags := make([]string, 0)
+	if attr, ok := d.GetOk("tags"); ok {
+		for _, tag := range attr.(*schema.Set).List() {
+			tags = append(tags, tag.(string))
+		}
+	}
+	return tags
+}
+
+// readTagsPtrFromResource only returns tags when some are set or they have just been removed, this keeps requests
+// working against kong versions before 1.1 which reject any request containing tags.
+func readTagsPtrFromResource(d *schema.ResourceData) *[]string {
+	tags := readTagsFromResource(d)
+	if len(tags) == 0 && !d.HasChange("tags") {
+		return nil
+	}
+	return &tags
+}
+
+func tagsFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tags": &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Only return entities tagged with these tags",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags_match": &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "any"}, false),
+			Description:  "Whether entities must have all of the tags or any of them",
+		},
+	}
+}
+
+// Kong returns entities having all of the tags when they are separated by a comma and any of them when they are
+// separated by a slash, the two cannot be mixed.
+func tagsFilterQuery(d *schema.ResourceData) string {
+	query := "?size=1000"
+
+	tags := gokong.StringValueSlice(readStringArrayPtrFromResource(d, "tags"))
+	if len(tags) == 0 {
+		return query
+	}
+
+	separator := ","
+	if readStringFromResource(d, "tags_match") == "any" {
+		separator = "/"
+	}
+
+	escaped := make([]string, len(tags))
+	for i, tag := range tags {
+		escaped[i] = url.QueryEscape(tag)
+	}
+
+	return query + "&tags=" + strings.Join(escaped, separator)
+}
+
+// listEntitiesByTags pages through every entity at path matching the tags filter of the data source.
+func listEntitiesByTags(d *schema.ResourceData, meta interface{}, path string) ([]json.RawMessage, string, error) {
+	pathWithQuery := path + tagsFilterQuery(d)
+
+	entities, err := meta.(*config).adminApi.list(pathWithQuery)
+
+	return entities, pathWithQuery, err
+}
@@ -0,0 +1,360 @@
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/kevholditch/gokong"
+)
+
+// Kong adds this port to targets configured without one
+const defaultTargetPort = "8000"
+
+// Targets can be updated in place from 2.2, before that a new entry for the same address replaces the old one
+const targetPatchMinimumKongVersion = "2.2.0"
+
+type upstreamTargetRequest struct {
+	Target string    `json:"target"`
+	Weight int       `json:"weight"`
+	Tags   *[]string `json:"tags,omitempty"`
+}
+
+type upstreamTarget struct {
+	Id        string   `json:"id"`
+	Target    string   `json:"target"`
+	Weight    int      `json:"weight"`
+	Tags      []string `json:"tags"`
+	CreatedAt float64  `json:"created_at"`
+}
+
+func upstreamTargetsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		ForceNew: false,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target": &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"weight": &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  100,
+				},
+				"tags": tagsSchema(),
+			},
+		},
+	}
+}
+
+// validateUpstreamTargetWeights rejects targets with a weight of 0 on kong before 2.2, which treats them as removed and
+// leaves them out of the targets of the upstream so they would be added again on every apply. It is skipped when kong
+// can not be reached.
+func validateUpstreamTargetWeights(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("target") || !d.NewValueKnown("target") {
+		return nil
+	}
+
+	var zeroWeight []string
+	for _, target := range readUpstreamTargetsFromDiff(d) {
+		if target.Weight == 0 {
+			zeroWeight = append(zeroWeight, target.Target)
+		}
+	}
+
+	if len(zeroWeight) == 0 {
+		return nil
+	}
+
+	canKeepZeroWeight, err := kongVersionAtLeast(meta, targetPatchMinimumKongVersion)
+
+	if err != nil {
+		log.Printf("[WARN] could not read the kong version, not validating target weights: %v", err)
+		return nil
+	}
+
+	if !canKeepZeroWeight {
+		sort.Strings(zeroWeight)
+		return fmt.Errorf("targets %s have a weight of 0, kong before %s treats them as removed, remove the targets instead",
+			strings.Join(zeroWeight, ", "), targetPatchMinimumKongVersion)
+	}
+
+	return nil
+}
+
+func upstreamTargetsPath(upstreamId string) string {
+	return fmt.Sprintf(gokong.TargetsPath, upstreamId)
+}
+
+// listUpstreamTargets returns the current target of every address of the upstream. gokong's
+// GetTargetsFromUpstreamId is not used as it drops the tags and fetches the first page again when there is a next one.
+func listUpstreamTargets(meta interface{}, upstreamId string) ([]*upstreamTarget, error) {
+	entities, err := meta.(*config).adminApi.list(upstreamTargetsPath(upstreamId) + "?size=1000")
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Kong 1.x keeps the history of a target, the latest entry of an address is the one in use
+	latest := map[string]*upstreamTarget{}
+	var addresses []string
+
+	for _, entity := range entities {
+		target := &upstreamTarget{}
+		if err := json.Unmarshal(entity, target); err != nil {
+			return nil, fmt.Errorf("could not parse kong target: %v", err)
+		}
+
+		current, ok := latest[target.Target]
+		if !ok {
+			addresses = append(addresses, target.Target)
+		}
+		if !ok || target.CreatedAt > current.CreatedAt {
+			latest[target.Target] = target
+		}
+	}
+
+	sort.Strings(addresses)
+
+	targets := make([]*upstreamTarget, 0, len(addresses))
+	for _, address := range addresses {
+		targets = append(targets, latest[address])
+	}
+
+	return targets, nil
+}
+
+func createUpstreamTarget(meta interface{}, upstreamId string, request *upstreamTargetRequest) (*upstreamTarget, error) {
+	target := &upstreamTarget{}
+
+	if err := meta.(*config).adminApi.post(upstreamTargetsPath(upstreamId), request, target); err != nil {
+		return nil, fmt.Errorf("failed to create kong target: %s error: %v", request.Target, err)
+	}
+
+	return target, nil
+}
+
+// updateUpstreamTarget changes the weight and tags of an existing target without removing it first, so the upstream
+// never goes without the target. It returns nil when the target no longer exists.
+func updateUpstreamTarget(meta interface{}, upstreamId string, existing *upstreamTarget, request *upstreamTargetRequest) (*upstreamTarget, error) {
+	canPatch, err := kongVersionAtLeast(meta, targetPatchMinimumKongVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !canPatch {
+		// A new entry would bring back a target that was removed, so make sure it is still there first
+		targets, err := listUpstreamTargets(meta, upstreamId)
+		if err != nil {
+			return nil, fmt.Errorf("could not read kong targets of upstream %s: %v", upstreamId, err)
+		}
+		if !containsUpstreamTarget(targets, existing.Id, request.Target) {
+			return nil, nil
+		}
+		return createUpstreamTarget(meta, upstreamId, request)
+	}
+
+	target := &upstreamTarget{}
+	status, err := meta.(*config).adminApi.do(http.MethodPatch, upstreamTargetsPath(upstreamId)+"/"+existing.Id, request, target)
+
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error updating kong target: %s error: %v", request.Target, err)
+	}
+
+	return target, nil
+}
+
+func containsUpstreamTarget(targets []*upstreamTarget, targetId string, address string) bool {
+	for _, target := range targets {
+		if target.Id == targetId || target.Target == normalizeTargetAddress(address) {
+			return true
+		}
+	}
+	return false
+}
+
+func deleteUpstreamTarget(meta interface{}, upstreamId string, targetId string) error {
+	if err := meta.(*config).adminApi.delete(upstreamTargetsPath(upstreamId) + "/" + targetId); err != nil {
+		return fmt.Errorf("could not delete kong target: %v", err)
+	}
+
+	return nil
+}
+
+// reconcileUpstreamTargets makes the targets of the upstream match the desired ones with as few calls as possible.
+// Targets are created and updated before any are deleted so the upstream is not left without targets in between.
+func reconcileUpstreamTargets(meta interface{}, upstreamId string, desired []*upstreamTargetRequest) error {
+	existing, err := listUpstreamTargets(meta, upstreamId)
+
+	if err != nil {
+		return fmt.Errorf("could not read kong targets of upstream %s: %v", upstreamId, err)
+	}
+
+	existingByAddress := map[string]*upstreamTarget{}
+	for _, target := range existing {
+		existingByAddress[target.Target] = target
+	}
+
+	wanted := map[string]bool{}
+
+	for _, request := range desired {
+		address := normalizeTargetAddress(request.Target)
+		wanted[address] = true
+
+		current, ok := existingByAddress[address]
+		if !ok {
+			if _, err := createUpstreamTarget(meta, upstreamId, request); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if current.Weight == request.Weight && sameTags(current.Tags, request.Tags) {
+			continue
+		}
+
+		// Tags that were removed need to be sent as an empty list to clear them
+		if request.Tags == nil && len(current.Tags) > 0 {
+			request.Tags = &[]string{}
+		}
+
+		if _, err := updateUpstreamTarget(meta, upstreamId, current, request); err != nil {
+			return err
+		}
+	}
+
+	for _, target := range existing {
+		if wanted[target.Target] {
+			continue
+		}
+		if err := deleteUpstreamTarget(meta, upstreamId, target.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readUpstreamTargetsFromResource(d *schema.ResourceData) []*upstreamTargetRequest {
+	return expandUpstreamTargets(d.Get("target"))
+}
+
+func readUpstreamTargetsFromDiff(d *schema.ResourceDiff) []*upstreamTargetRequest {
+	return expandUpstreamTargets(d.Get("target"))
+}
+
+func expandUpstreamTargets(value interface{}) []*upstreamTargetRequest {
+	var targets []*upstreamTargetRequest
+
+	set, ok := value.(*schema.Set)
+	if !ok {
+		return targets
+	}
+
+	for _, item := range set.List() {
+		targets = append(targets, expandUpstreamTarget(item.(map[string]interface{})))
+	}
+
+	return targets
+}
+
+func expandUpstreamTarget(m map[string]interface{}) *upstreamTargetRequest {
+	request := &upstreamTargetRequest{
+		Target: m["target"].(string),
+		Weight: m["weight"].(int),
+	}
+
+	if tagSet, ok := m["tags"].(*schema.Set); ok && tagSet.Len() > 0 {
+		tags := make([]string, 0, tagSet.Len())
+		for _, tag := range tagSet.List() {
+			tags = append(tags, tag.(string))
+		}
+		request.Tags = &tags
+	}
+
+	return request
+}
+
+// flattenUpstreamTargets keeps the configured spelling of an address when kong only added the default port to it
+func flattenUpstreamTargets(targets []*upstreamTarget, configured []*upstreamTargetRequest) []interface{} {
+	configuredAddresses := map[string]string{}
+	for _, target := range configured {
+		configuredAddresses[normalizeTargetAddress(target.Target)] = target.Target
+	}
+
+	result := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		address := target.Target
+		if configuredAddress, ok := configuredAddresses[address]; ok {
+			address = configuredAddress
+		}
+
+		tags := target.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		result = append(result, map[string]interface{}{
+			"target": address,
+			"weight": target.Weight,
+			"tags":   tags,
+		})
+	}
+
+	return result
+}
+
+// normalizeTargetAddress adds the port kong adds to a target configured without one, so configured and returned
+// targets can be compared
+func normalizeTargetAddress(address string) string {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address
+	}
+
+	if strings.HasPrefix(address, "[") {
+		return address + ":" + defaultTargetPort
+	}
+
+	if strings.Contains(address, ":") {
+		return "[" + address + "]:" + defaultTargetPort
+	}
+
+	return address + ":" + defaultTargetPort
+}
+
+func sameTags(current []string, desired *[]string) bool {
+	var wanted []string
+	if desired != nil {
+		wanted = *desired
+	}
+
+	if len(current) != len(wanted) {
+		return false
+	}
+
+	sortedCurrent := append([]string{}, current...)
+	sortedWanted := append([]string{}, wanted...)
+	sort.Strings(sortedCurrent)
+	sort.Strings(sortedWanted)
+
+	for i := range sortedCurrent {
+		if sortedCurrent[i] != sortedWanted[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,40 @@
+package kong
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Kong Enterprise scopes entities to a workspace by prefixing the admin api path with the workspace name, entities
+// without a workspace live in the default workspace.
+
+func workspaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The workspace the entity belongs to, the default workspace when not set",
+	}
+}
+
+func workspacePath(d *schema.ResourceData, path string) string {
+	if workspace := readStringFromResource(d, "workspace"); workspace != "" {
+		return "/" + workspace + path
+	}
+	return path
+}
+
+// importWorkspaceEntity imports an id of the form [workspace/]id where id is made of `parts` slash separated segments
+func importWorkspaceEntity(parts int) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		idSplit := strings.SplitN(d.Id(), "/", parts+1)
+
+		if len(idSplit) == parts+1 {
+			d.Set("workspace", idSplit[0])
+			d.SetId(strings.Join(idSplit[1:], "/"))
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}